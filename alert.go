@@ -0,0 +1,240 @@
+/*
+ Copyright © 2025 Dell Inc. or its subsidiaries. All Rights Reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package gounity
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/dell/gounity/api"
+	types "github.com/dell/gounity/apitypes"
+	util "github.com/dell/gounity/gounityutil"
+)
+
+// AlertSeverity is integer. Lower values are more severe.
+type AlertSeverity int
+
+// AlertSeverity constants
+const (
+	AlertSeverityEmergency AlertSeverity = 0
+	AlertSeverityAlert     AlertSeverity = 1
+	AlertSeverityCritical  AlertSeverity = 2
+	AlertSeverityError     AlertSeverity = 3
+	AlertSeverityWarning   AlertSeverity = 4
+	AlertSeverityNotice    AlertSeverity = 5
+	AlertSeverityInfo      AlertSeverity = 6
+	AlertSeverityDebug     AlertSeverity = 7
+	AlertSeverityOK        AlertSeverity = 8
+)
+
+// AlertsPageSize is the number of alerts or events requested per page
+const AlertsPageSize = 1000
+
+// unityTimeFormat is the timestamp format accepted by Unity filters
+const unityTimeFormat = "2006-01-02T15:04:05.000Z"
+
+// DefaultAlertWatchInterval is the interval at which WatchAlerts polls the array unless another one is given
+const DefaultAlertWatchInterval = 30 * time.Second
+
+// severityTimeFilter builds a Unity filter on severity and a time attribute
+func severityTimeFilter(minSeverity AlertSeverity, timeField, op string, since time.Time) string {
+	filters := []string{fmt.Sprintf("severity le %d", minSeverity)}
+	if !since.IsZero() {
+		filters = append(filters, fmt.Sprintf("%s %s \"%s\"", timeField, op, since.UTC().Format(unityTimeFormat)))
+	}
+	return url.QueryEscape(strings.Join(filters, " and "))
+}
+
+// ListAlerts lists the alerts which are at least as severe as minSeverity and raised after the given time.
+// Pass AlertSeverityOK to list alerts of every severity and a zero time to list alerts of any age.
+// Alerts are returned ordered by timestamp.
+// - Example: GET /api/types/alert/instances?fields=...&filter=severity le 4 and timestamp gt "2025-01-01T00:00:00.000Z"
+func (c *UnityClientImpl) ListAlerts(ctx context.Context, minSeverity AlertSeverity, since time.Time) ([]types.Alert, error) {
+	return c.listAlerts(ctx, severityTimeFilter(minSeverity, "timestamp", "gt", since))
+}
+
+func (c *UnityClientImpl) listAlerts(ctx context.Context, filter string) ([]types.Alert, error) {
+	log := util.GetRunIDLogger(ctx)
+	alertsURI := fmt.Sprintf(api.UnityAPIInstanceTypeResourcesWithFields, api.AlertAction, AlertDisplayFields) + "&filter=" + filter
+
+	alerts := []types.Alert{}
+	for page := 1; ; page++ {
+		queryURI := alertsURI + fmt.Sprintf("&per_page=%d&page=%d", AlertsPageSize, page)
+		log.Debug("ListAlerts: ", queryURI)
+
+		alertsResp := &types.ListAlerts{}
+		err := c.executeWithRetryAuthenticate(ctx, http.MethodGet, queryURI, nil, alertsResp)
+		if err != nil {
+			return nil, fmt.Errorf("unable to list alerts: %v", err)
+		}
+		alerts = append(alerts, alertsResp.Alerts...)
+		if len(alertsResp.Alerts) < AlertsPageSize {
+			break
+		}
+	}
+
+	sort.SliceStable(alerts, func(i, j int) bool {
+		return alerts[i].AlertContent.Timestamp.Before(alerts[j].AlertContent.Timestamp)
+	})
+	return alerts, nil
+}
+
+// AcknowledgeAlert marks the alert with the given ID as acknowledged.
+func (c *UnityClientImpl) AcknowledgeAlert(ctx context.Context, alertID string) (err error) {
+	ctx, span := c.startOperation(ctx, "AcknowledgeAlert", attributeResourceID.String(alertID))
+	defer func() { endOperation(span, err) }()
+	if alertID == "" {
+		return errors.New("alert ID cannot be empty")
+	}
+
+	alertModifyParam := types.AlertModifyParam{
+		IsAcknowledged: true,
+	}
+	err = c.executeWithRetryAuthenticate(ctx, http.MethodPost, fmt.Sprintf(api.UnityModifyAlertURI, api.AlertAction, alertID), alertModifyParam, nil)
+	if err != nil {
+		return fmt.Errorf("acknowledge Alert %s Failed. Error: %v", alertID, err)
+	}
+	return nil
+}
+
+// DeleteAlert deletes the alert with the given ID.
+func (c *UnityClientImpl) DeleteAlert(ctx context.Context, alertID string) (err error) {
+	ctx, span := c.startOperation(ctx, "DeleteAlert", attributeResourceID.String(alertID))
+	defer func() { endOperation(span, err) }()
+	if alertID == "" {
+		return errors.New("alert ID cannot be empty")
+	}
+
+	err = c.executeWithRetryAuthenticate(ctx, http.MethodDelete, fmt.Sprintf(api.UnityAPIGetResourceURI, api.AlertAction, alertID), nil, nil)
+	if err != nil {
+		return fmt.Errorf("delete Alert %s Failed. Error: %v", alertID, err)
+	}
+	return nil
+}
+
+// ListEvents lists the event log entries which are at least as severe as minSeverity and created after the given time.
+// Pass AlertSeverityOK to list events of every severity and a zero time to list events of any age.
+// - Example: GET /api/types/event/instances?fields=...&filter=severity le 4 and creationTime gt "2025-01-01T00:00:00.000Z"
+func (c *UnityClientImpl) ListEvents(ctx context.Context, minSeverity AlertSeverity, since time.Time) ([]types.Event, error) {
+	log := util.GetRunIDLogger(ctx)
+	eventsURI := fmt.Sprintf(api.UnityAPIInstanceTypeResourcesWithFields, api.EventAction, EventDisplayFields) +
+		"&filter=" + severityTimeFilter(minSeverity, "creationTime", "gt", since)
+
+	events := []types.Event{}
+	for page := 1; ; page++ {
+		queryURI := eventsURI + fmt.Sprintf("&per_page=%d&page=%d", AlertsPageSize, page)
+		log.Debug("ListEvents: ", queryURI)
+
+		eventsResp := &types.ListEvents{}
+		err := c.executeWithRetryAuthenticate(ctx, http.MethodGet, queryURI, nil, eventsResp)
+		if err != nil {
+			return nil, fmt.Errorf("unable to list events: %v", err)
+		}
+		events = append(events, eventsResp.Events...)
+		if len(eventsResp.Events) < AlertsPageSize {
+			break
+		}
+	}
+	return events, nil
+}
+
+// WatchAlerts polls the array at the given interval, or every DefaultAlertWatchInterval if it is zero or less,
+// and sends alerts raised after `since` on the returned channel.
+// Each alert is sent once. The watcher tracks the timestamp of the newest alert sent (the high-water mark),
+// so a caller that persists the timestamp of the last alert it received can pass it as `since` after a restart
+// without receiving old alerts again. The channel is closed when ctx is cancelled.
+// Polling errors are logged and the poll is retried on the next interval.
+func (c *UnityClientImpl) WatchAlerts(ctx context.Context, since time.Time, interval time.Duration) <-chan types.Alert {
+	if interval <= 0 {
+		interval = DefaultAlertWatchInterval
+	}
+	alertCh := make(chan types.Alert)
+	go func() {
+		defer close(alertCh)
+		watcher := alertWatcher{highWaterMark: since, seen: map[string]bool{}, exclusive: !since.IsZero()}
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			if !c.pollAlerts(ctx, &watcher, alertCh) {
+				return
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+	return alertCh
+}
+
+// alertWatcher holds the high-water mark of WatchAlerts and the IDs of the alerts already sent at that time.
+// Until the first alert is sent, alerts raised exactly at the `since` time given by the caller are skipped.
+type alertWatcher struct {
+	highWaterMark time.Time
+	seen          map[string]bool
+	exclusive     bool
+}
+
+// newAlerts returns the alerts which were not sent yet and advances the high-water mark
+func (w *alertWatcher) newAlerts(alerts []types.Alert) []types.Alert {
+	var result []types.Alert
+	for _, alert := range alerts {
+		timestamp := alert.AlertContent.Timestamp
+		if !timestamp.After(w.highWaterMark) && (w.exclusive || timestamp.Before(w.highWaterMark) || w.seen[alert.AlertContent.ID]) {
+			continue
+		}
+		if timestamp.After(w.highWaterMark) {
+			w.highWaterMark = timestamp
+			w.seen = map[string]bool{}
+			w.exclusive = false
+		}
+		w.seen[alert.AlertContent.ID] = true
+		result = append(result, alert)
+	}
+	return result
+}
+
+// pollAlerts sends the new alerts on alertCh. It returns false if ctx was cancelled.
+func (c *UnityClientImpl) pollAlerts(ctx context.Context, watcher *alertWatcher, alertCh chan<- types.Alert) bool {
+	log := util.GetRunIDLogger(ctx)
+
+	// Alerts raised at the high-water mark itself are fetched again and filtered by ID
+	op := "ge"
+	if watcher.exclusive {
+		op = "gt"
+	}
+	filter := severityTimeFilter(AlertSeverityOK, "timestamp", op, watcher.highWaterMark)
+	alerts, err := c.listAlerts(ctx, filter)
+	if err != nil {
+		log.Warnf("WatchAlerts: polling alerts failed with error: %v", err)
+		return ctx.Err() == nil
+	}
+
+	for _, alert := range watcher.newAlerts(alerts) {
+		select {
+		case <-ctx.Done():
+			return false
+		case alertCh <- alert:
+		}
+	}
+	return true
+}
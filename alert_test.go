@@ -0,0 +1,177 @@
+/*
+ Copyright © 2025 Dell Inc. or its subsidiaries. All Rights Reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package gounity
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"testing"
+	"time"
+
+	types "github.com/dell/gounity/apitypes"
+	mocksapi "github.com/dell/gounity/mocks/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func newTestAlert(id string, timestamp time.Time) types.Alert {
+	return types.Alert{AlertContent: types.AlertContent{ID: id, Timestamp: timestamp, Severity: int(AlertSeverityError)}}
+}
+
+func TestListAlerts(t *testing.T) {
+	fmt.Println("Begin - List Alerts Test")
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).ExpectedCalls = nil
+	ctx := context.Background()
+	since := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	first := time.Date(2025, 1, 3, 0, 0, 0, 0, time.UTC)
+	second := time.Date(2025, 1, 4, 0, 0, 0, 0, time.UTC)
+
+	filter := url.QueryEscape(`severity le 4 and timestamp gt "2025-01-02T03:04:05.000Z"`)
+	uri := fmt.Sprintf("/api/types/alert/instances?fields=%s&filter=%s&per_page=%d&page=1", AlertDisplayFields, filter, AlertsPageSize)
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", mock.Anything, "GET", uri, mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(*types.ListAlerts)
+		resp.Alerts = []types.Alert{newTestAlert("alert_2", second), newTestAlert("alert_1", first)}
+	}).Once()
+
+	alerts, err := testConf.client.ListAlerts(ctx, AlertSeverityWarning, since)
+	assert.NoError(t, err)
+	assert.Len(t, alerts, 2)
+	assert.Equal(t, "alert_1", alerts[0].AlertContent.ID)
+
+	// Negative case
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", anyArgs...).Return(errors.New("list alerts failed")).Once()
+	_, err = testConf.client.ListAlerts(ctx, AlertSeverityOK, time.Time{})
+	assert.Error(t, err)
+
+	fmt.Println("List Alerts Test - Successful")
+}
+
+func TestAcknowledgeAlert(t *testing.T) {
+	fmt.Println("Begin - Acknowledge Alert Test")
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).ExpectedCalls = nil
+	ctx := context.Background()
+
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", mock.Anything, "POST", "/api/instances/alert/alert_1/action/modify", mock.Anything, types.AlertModifyParam{IsAcknowledged: true}, mock.Anything).Return(nil).Once()
+	err := testConf.client.AcknowledgeAlert(ctx, "alert_1")
+	assert.NoError(t, err)
+
+	// Negative cases
+	err = testConf.client.AcknowledgeAlert(ctx, "")
+	assert.Error(t, err)
+
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", anyArgs...).Return(errors.New("acknowledge failed")).Once()
+	err = testConf.client.AcknowledgeAlert(ctx, "alert_1")
+	assert.Error(t, err)
+
+	fmt.Println("Acknowledge Alert Test - Successful")
+}
+
+func TestDeleteAlert(t *testing.T) {
+	fmt.Println("Begin - Delete Alert Test")
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).ExpectedCalls = nil
+	ctx := context.Background()
+
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", mock.Anything, "DELETE", "/api/instances/alert/alert_1", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+	err := testConf.client.DeleteAlert(ctx, "alert_1")
+	assert.NoError(t, err)
+
+	// Negative cases
+	err = testConf.client.DeleteAlert(ctx, "")
+	assert.Error(t, err)
+
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", anyArgs...).Return(errors.New("delete failed")).Once()
+	err = testConf.client.DeleteAlert(ctx, "alert_1")
+	assert.Error(t, err)
+
+	fmt.Println("Delete Alert Test - Successful")
+}
+
+func TestListEvents(t *testing.T) {
+	fmt.Println("Begin - List Events Test")
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).ExpectedCalls = nil
+	ctx := context.Background()
+
+	filter := url.QueryEscape("severity le 8")
+	uri := fmt.Sprintf("/api/types/event/instances?fields=%s&filter=%s&per_page=%d&page=1", EventDisplayFields, filter, AlertsPageSize)
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", mock.Anything, "GET", uri, mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(*types.ListEvents)
+		resp.Events = []types.Event{{EventContent: types.EventContent{ID: "event_1", Message: "Logged in"}}}
+	}).Once()
+
+	events, err := testConf.client.ListEvents(ctx, AlertSeverityOK, time.Time{})
+	assert.NoError(t, err)
+	assert.Len(t, events, 1)
+
+	// Negative case
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", anyArgs...).Return(errors.New("list events failed")).Once()
+	_, err = testConf.client.ListEvents(ctx, AlertSeverityOK, time.Time{})
+	assert.Error(t, err)
+
+	fmt.Println("List Events Test - Successful")
+}
+
+func TestAlertWatcherNewAlerts(t *testing.T) {
+	since := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	later := since.Add(time.Minute)
+	watcher := alertWatcher{highWaterMark: since, seen: map[string]bool{}, exclusive: true}
+
+	// Alerts at or before `since` are skipped after a restart
+	alerts := watcher.newAlerts([]types.Alert{newTestAlert("old", since.Add(-time.Minute)), newTestAlert("at-since", since), newTestAlert("new", later)})
+	assert.Len(t, alerts, 1)
+	assert.Equal(t, "new", alerts[0].AlertContent.ID)
+	assert.Equal(t, later, watcher.highWaterMark)
+
+	// Alerts raised at the high-water mark are sent once
+	alerts = watcher.newAlerts([]types.Alert{newTestAlert("new", later), newTestAlert("same-time", later)})
+	assert.Len(t, alerts, 1)
+	assert.Equal(t, "same-time", alerts[0].AlertContent.ID)
+
+	alerts = watcher.newAlerts([]types.Alert{newTestAlert("new", later), newTestAlert("same-time", later)})
+	assert.Empty(t, alerts)
+}
+
+func TestWatchAlerts(t *testing.T) {
+	fmt.Println("Begin - Watch Alerts Test")
+	apiClient := &mocksapi.Client{}
+	client := &UnityClientImpl{api: apiClient, configConnect: &ConfigConnect{}}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	first := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	apiClient.On("DoWithHeaders", anyArgs...).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(*types.ListAlerts)
+		resp.Alerts = []types.Alert{newTestAlert("alert_1", first)}
+	}).Once()
+	apiClient.On("DoWithHeaders", anyArgs...).Return(errors.New("array unreachable")).Once()
+	apiClient.On("DoWithHeaders", anyArgs...).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(*types.ListAlerts)
+		resp.Alerts = []types.Alert{newTestAlert("alert_1", first), newTestAlert("alert_2", first.Add(time.Second))}
+	})
+
+	alertCh := client.WatchAlerts(ctx, time.Time{}, 10*time.Millisecond)
+	alert := <-alertCh
+	assert.Equal(t, "alert_1", alert.AlertContent.ID)
+	alert = <-alertCh
+	assert.Equal(t, "alert_2", alert.AlertContent.ID)
+
+	cancel()
+	for range alertCh {
+		t.Fatal("no more alerts expected")
+	}
+
+	fmt.Println("Watch Alerts Test - Successful")
+}
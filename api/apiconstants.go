@@ -57,6 +57,9 @@ const (
 	// UnityAPILoginSessionInfoURI LOGINS resource URIs
 	UnityAPILoginSessionInfoURI = unityAPITypes + "/loginSessionInfo"
 
+	// UnityAPILogoutURI ends the login session
+	UnityAPILogoutURI = UnityAPILoginSessionInfoURI + "/action/logout"
+
 	// UnityAPIBasicSysInfoURI gets BasicSystemInfo URI
 	UnityAPIBasicSysInfoURI = unityAPITypes + "/basicSystemInfo/instances"
 
@@ -89,6 +92,12 @@ const (
 	// UnityCopySnapshotURI does Snapshot Copy Action
 	UnityCopySnapshotURI = UnityAPIGetResourceURI + "/action/copy"
 
+	// UnityModifyAlertURI Alert Action resource URIs
+	UnityModifyAlertURI = UnityAPIGetResourceURI + "/action/modify"
+
+	// UnityModifyPoolURI Pool Action resource URIs
+	UnityModifyPoolURI = UnityAPIGetResourceURI + "/action/modify"
+
 	// UnityAPIGetMaxVolumeSize gets the maximum volume size of an array {1}=unique identifier of the systemLimit instance, {2}=fields
 	UnityAPIGetMaxVolumeSize = UnityAPIInstancesURI + "/systemLimit/%s?fields=%s"
 
@@ -102,6 +111,7 @@ const (
 	UnityMetric              = "metric"
 	UnityMetricQueryResult   = "metricQueryResult"
 	UnityMetricRealTimeQuery = "metricRealTimeQuery"
+	UnityMetricValue         = "metricValue"
 
 	// UnitySystemCapacity is used to get capacity metrics for Unity XT
 	UnitySystemCapacity = "systemCapacity"
@@ -117,7 +127,11 @@ const (
 	HostAction                = "host"
 	IPInterface               = "ipInterface"
 	SnapAction                = "snap"
+	SnapScheduleAction        = "snapSchedule"
+	ReplicationSessionAction  = "replicationSession"
 	PoolAction                = "pool"
+	PoolUnitAction            = "poolUnit"
+	DiskGroupAction           = "diskGroup"
 	IOLimitPolicy             = "ioLimitPolicy"
 	LicenseAction             = "license"
 	HostInitiatorPathAction   = "hostInitiatorPath"
@@ -125,6 +139,18 @@ const (
 	HostIPPortAction          = "hostIPPort"
 	NasServerAction           = "nasServer"
 	TenantAction              = "tenant"
+	AlertAction               = "alert"
+	BasicSystemInfoAction     = "basicSystemInfo"
+	SystemAction              = "system"
+	StorageProcessorAction    = "storageProcessor"
+	DpeAction                 = "dpe"
+	DaeAction                 = "dae"
+	DiskAction                = "disk"
+	PowerSupplyAction         = "powerSupply"
+	BatteryAction             = "battery"
+	FanAction                 = "fan"
+	EventAction               = "event"
+	LoginSessionInfoAction    = "loginSessionInfo"
 	UnityNFSServer            = "nfsServer"
 	UnityNFSv3AndNFSv4Enabled = "nfsv3Enabled,nfsv4Enabled"
 )
@@ -0,0 +1,137 @@
+/*
+ Copyright © 2025 Dell Inc. or its subsidiaries. All Rights Reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package api
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	util "github.com/dell/gounity/gounityutil"
+	"github.com/sirupsen/logrus"
+)
+
+// Request is the request seen by interceptors
+type Request struct {
+	Method string
+	// URI is the URI given by the caller, relative to the array endpoint
+	URI string
+	// Header is the header of the request. Changes made by Before are sent.
+	Header http.Header
+	// Body is the body given by the caller, before it is encoded
+	Body        interface{}
+	HTTPRequest *http.Request
+}
+
+// Response is the outcome of a request seen by interceptors
+type Response struct {
+	// StatusCode and Header are empty if the request failed
+	StatusCode int
+	Header     http.Header
+	// Duration is the time until the response headers were received or the request failed
+	Duration     time.Duration
+	Err          error
+	HTTPResponse *http.Response
+}
+
+// Interceptor observes or alters every request made by the client. Both functions are optional.
+type Interceptor struct {
+	// Before is called before the request is sent, in registration order. The returned context is used
+	// for the request and the next interceptors. Returning an error aborts the request with that error.
+	Before func(ctx context.Context, req *Request) (context.Context, error)
+	// After is called once the response headers are received or the request failed, in reverse registration
+	// order. It is called only for interceptors whose Before succeeded.
+	After func(ctx context.Context, req *Request, resp *Response)
+}
+
+// interceptorChain runs the interceptors of a client
+type interceptorChain []Interceptor
+
+// before calls the Before functions and returns the number of interceptors whose Before succeeded
+func (chain interceptorChain) before(ctx context.Context, req *Request) (context.Context, int, error) {
+	for i, interceptor := range chain {
+		if interceptor.Before == nil {
+			continue
+		}
+		next, err := interceptor.Before(ctx, req)
+		if err != nil {
+			return ctx, i, err
+		}
+		if next != nil {
+			ctx = next
+		}
+	}
+	return ctx, len(chain), nil
+}
+
+// after calls the After functions of the first n interceptors in reverse order
+func (chain interceptorChain) after(ctx context.Context, n int, req *Request, resp *Response) {
+	for i := n - 1; i >= 0; i-- {
+		if chain[i].After != nil {
+			chain[i].After(ctx, req, resp)
+		}
+	}
+}
+
+// LoggingInterceptor logs the method, URI, status code and duration of every request at the given level
+func LoggingInterceptor(level logrus.Level) Interceptor {
+	return Interceptor{
+		After: func(ctx context.Context, req *Request, resp *Response) {
+			log := util.GetRunIDLogger(ctx)
+			if resp.Err != nil {
+				log.Logf(level, "%s %s failed after %v: %v", req.Method, req.URI, resp.Duration, resp.Err)
+				return
+			}
+			log.Logf(level, "%s %s returned %d in %v", req.Method, req.URI, resp.StatusCode, resp.Duration)
+		},
+	}
+}
+
+// TimingInterceptor calls observe with the method, URI, status code and duration of every request.
+// The status code is zero if the request failed.
+func TimingInterceptor(observe func(method, uri string, statusCode int, duration time.Duration)) Interceptor {
+	return Interceptor{
+		After: func(_ context.Context, req *Request, resp *Response) {
+			observe(req.Method, req.URI, resp.StatusCode, resp.Duration)
+		},
+	}
+}
+
+// HeaderInterceptor sets the given headers on every request, replacing those set by the caller
+func HeaderInterceptor(headers map[string]string) Interceptor {
+	return Interceptor{
+		Before: func(ctx context.Context, req *Request) (context.Context, error) {
+			for header, value := range headers {
+				req.Header.Set(header, value)
+			}
+			return ctx, nil
+		},
+	}
+}
+
+// ShowHTTPInterceptor dumps every request and response, as enabled by ClientOptions.ShowHTTP
+func ShowHTTPInterceptor() Interceptor {
+	return Interceptor{
+		Before: func(ctx context.Context, req *Request) (context.Context, error) {
+			logRequest(ctx, req.HTTPRequest, nil)
+			return ctx, nil
+		},
+		After: func(ctx context.Context, _ *Request, resp *Response) {
+			if resp.HTTPResponse != nil {
+				logResponse(ctx, resp.HTTPResponse, nil)
+			}
+		},
+	}
+}
@@ -0,0 +1,96 @@
+// Copyright © 2025 Dell Inc. or its subsidiaries. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//      http://www.apache.org/licenses/LICENSE-2.0
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type interceptorKey struct{}
+
+func TestInterceptors(t *testing.T) {
+	ctx := context.Background()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Trace", r.Header.Get("X-Trace"))
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	var order []string
+	var observed time.Duration
+	recorder := Interceptor{
+		Before: func(ctx context.Context, req *Request) (context.Context, error) {
+			order = append(order, "before "+req.Method+" "+req.URI)
+			assert.Equal(t, map[string]string{"name": "lun"}, req.Body)
+			return context.WithValue(ctx, interceptorKey{}, "value"), nil
+		},
+		After: func(ctx context.Context, _ *Request, resp *Response) {
+			order = append(order, "after")
+			assert.Equal(t, "value", ctx.Value(interceptorKey{}))
+			assert.Equal(t, "trace-1", resp.Header.Get("X-Trace"))
+		},
+	}
+	c, err := New(ctx, server.URL, ClientOptions{ShowHTTP: true, Interceptors: []Interceptor{
+		HeaderInterceptor(map[string]string{"X-Trace": "trace-1"}),
+		recorder,
+		LoggingInterceptor(logrus.DebugLevel),
+		TimingInterceptor(func(method, _ string, statusCode int, duration time.Duration) {
+			order = append(order, "timing")
+			assert.Equal(t, http.MethodPost, method)
+			assert.Equal(t, http.StatusCreated, statusCode)
+			observed = duration
+		}),
+	}}, false)
+	require.NoError(t, err)
+
+	_, err = c.DoAndGetResponseBody(ctx, http.MethodPost, "/api/types/lun/instances", nil, map[string]string{"name": "lun"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"before POST /api/types/lun/instances", "timing", "after"}, order)
+	assert.Positive(t, observed)
+}
+
+func TestInterceptorAbort(t *testing.T) {
+	ctx := context.Background()
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	injected := errors.New("injected fault")
+	var afterErr error
+	var lastAfterCalled bool
+	c, err := New(ctx, server.URL, ClientOptions{Interceptors: []Interceptor{
+		{After: func(_ context.Context, _ *Request, resp *Response) { afterErr = resp.Err }},
+		{Before: func(ctx context.Context, _ *Request) (context.Context, error) { return ctx, injected }},
+		{After: func(_ context.Context, _ *Request, _ *Response) { lastAfterCalled = true }},
+	}}, false)
+	require.NoError(t, err)
+
+	_, err = c.DoAndGetResponseBody(ctx, http.MethodGet, "/api/types/lun/instances", nil, nil)
+	assert.ErrorIs(t, err, injected)
+	assert.ErrorIs(t, afterErr, injected)
+	assert.False(t, lastAfterCalled)
+	assert.Zero(t, requests)
+}
@@ -0,0 +1,115 @@
+/*
+ Copyright © 2025 Dell Inc. or its subsidiaries. All Rights Reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package api
+
+import (
+	"context"
+	"io"
+	"math"
+	"net/http"
+	"sync"
+	"time"
+
+	util "github.com/dell/gounity/gounityutil"
+	"golang.org/x/time/rate"
+)
+
+// limiterWaitLogThreshold is the wait above which a limited request is logged
+const limiterWaitLogThreshold = 10 * time.Millisecond
+
+// RequestLimit bounds a class of requests sent to the array. The zero value does not limit requests.
+type RequestLimit struct {
+	// MaxInFlight is the maximum number of requests in progress. A request is in progress until its response body is closed.
+	MaxInFlight int
+	// Rate is the number of requests per second allowed by the token bucket.
+	Rate float64
+	// Burst is the size of the token bucket. It defaults to Rate rounded up.
+	Burst int
+}
+
+// limiter applies a RequestLimit
+type limiter struct {
+	class string
+	slots chan struct{}
+	rate  *rate.Limiter
+}
+
+// newLimiter returns the limiter of a class of requests, or nil if the limit is the zero value
+func newLimiter(class string, limit RequestLimit) *limiter {
+	if limit.MaxInFlight <= 0 && limit.Rate <= 0 {
+		return nil
+	}
+	l := &limiter{class: class}
+	if limit.MaxInFlight > 0 {
+		l.slots = make(chan struct{}, limit.MaxInFlight)
+	}
+	if limit.Rate > 0 {
+		burst := limit.Burst
+		if burst <= 0 {
+			burst = int(math.Ceil(limit.Rate))
+		}
+		l.rate = rate.NewLimiter(rate.Limit(limit.Rate), burst)
+	}
+	return l
+}
+
+// acquire waits for a token and a free slot. The returned function releases the slot.
+// A nil limiter does not wait.
+func (l *limiter) acquire(ctx context.Context, method, uri string) (func(), error) {
+	if l == nil {
+		return func() {}, nil
+	}
+	log := util.GetRunIDLogger(ctx)
+	start := time.Now()
+	if l.rate != nil {
+		if err := l.rate.Wait(ctx); err != nil {
+			log.Debugf("Gave up waiting for the %s rate limit of %s %s after %v: %v", l.class, method, uri, time.Since(start), err)
+			return nil, err
+		}
+	}
+	release := func() {}
+	if l.slots != nil {
+		select {
+		case l.slots <- struct{}{}:
+			var once sync.Once
+			release = func() {
+				once.Do(func() { <-l.slots })
+			}
+		case <-ctx.Done():
+			log.Debugf("Gave up waiting for a %s request slot for %s %s after %v: %v", l.class, method, uri, time.Since(start), ctx.Err())
+			return nil, ctx.Err()
+		}
+	}
+	if waited := time.Since(start); waited >= limiterWaitLogThreshold {
+		log.Debugf("Waited %v for the %s request limit of %s %s", waited, l.class, method, uri)
+	}
+	return release, nil
+}
+
+// isMutating returns true if the method changes the array
+func isMutating(method string) bool {
+	return method != http.MethodGet && method != http.MethodHead
+}
+
+// releaseOnClose releases a request slot when the response body is closed
+type releaseOnClose struct {
+	io.ReadCloser
+	release func()
+}
+
+func (r *releaseOnClose) Close() error {
+	defer r.release()
+	return r.ReadCloser.Close()
+}
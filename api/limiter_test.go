@@ -0,0 +1,119 @@
+/*
+ Copyright © 2025 Dell Inc. or its subsidiaries. All Rights Reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConcurrencyLimit(t *testing.T) {
+	ctx := context.Background()
+	var inFlight, maxInFlight atomic.Int32
+	unblock := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			current := inFlight.Add(1)
+			defer inFlight.Add(-1)
+			for {
+				previous := maxInFlight.Load()
+				if current <= previous || maxInFlight.CompareAndSwap(previous, current) {
+					break
+				}
+			}
+			<-unblock
+		}
+		_, _ = w.Write([]byte("{}"))
+	}))
+	defer server.Close()
+
+	c, err := New(ctx, server.URL, ClientOptions{ReadLimit: RequestLimit{MaxInFlight: 2}, WriteLimit: RequestLimit{MaxInFlight: 1}}, false)
+	require.NoError(t, err)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.NoError(t, c.DoWithHeaders(ctx, http.MethodGet, "/api/types/pool/instances", nil, nil, nil))
+		}()
+	}
+	assert.Eventually(t, func() bool { return inFlight.Load() == 2 }, time.Second, 5*time.Millisecond)
+
+	// Mutating requests are not queued behind the blocked reads
+	assert.NoError(t, c.DoWithHeaders(ctx, http.MethodPost, "/api/types/lun/instances", nil, map[string]string{}, nil))
+
+	// A read waiting for a slot gives up with its context
+	cancelCtx, cancel := context.WithTimeout(ctx, 20*time.Millisecond)
+	defer cancel()
+	err = c.DoWithHeaders(cancelCtx, http.MethodGet, "/api/types/pool/instances", nil, nil, nil)
+	assert.ErrorContains(t, err, context.DeadlineExceeded.Error())
+
+	close(unblock)
+	wg.Wait()
+	assert.Equal(t, int32(2), maxInFlight.Load())
+	assert.Len(t, c.(*client).readLimiter.slots, 0)
+	assert.Len(t, c.(*client).writeLimiter.slots, 0)
+}
+
+func TestRateLimit(t *testing.T) {
+	ctx := context.Background()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("{}"))
+	}))
+	defer server.Close()
+
+	c, err := New(ctx, server.URL, ClientOptions{WriteLimit: RequestLimit{Rate: 20, Burst: 1}}, false)
+	require.NoError(t, err)
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		assert.NoError(t, c.DoWithHeaders(ctx, http.MethodDelete, "/api/instances/lun/sv_1", nil, nil, nil))
+	}
+	assert.GreaterOrEqual(t, time.Since(start), 90*time.Millisecond)
+
+	// Reads are not rate limited
+	start = time.Now()
+	for i := 0; i < 3; i++ {
+		assert.NoError(t, c.DoWithHeaders(ctx, http.MethodGet, "/api/instances/lun/sv_1", nil, nil, nil))
+	}
+	assert.Less(t, time.Since(start), 90*time.Millisecond)
+
+	// Negative case: the context expires before a token is available
+	cancelCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	assert.Error(t, c.DoWithHeaders(cancelCtx, http.MethodDelete, "/api/instances/lun/sv_1", nil, nil, nil))
+}
+
+func TestNewLimiter(t *testing.T) {
+	assert.Nil(t, newLimiter("read", RequestLimit{}))
+	l := newLimiter("read", RequestLimit{Rate: 2.5})
+	assert.Equal(t, 3, l.rate.Burst())
+	assert.Nil(t, l.slots)
+
+	// A nil limiter does not wait
+	var noLimit *limiter
+	release, err := noLimit.acquire(context.Background(), http.MethodGet, "/api/types/pool/instances")
+	assert.NoError(t, err)
+	release()
+}
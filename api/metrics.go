@@ -0,0 +1,163 @@
+/*
+ Copyright © 2025 Dell Inc. or its subsidiaries. All Rights Reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package api
+
+import (
+	"errors"
+	"strconv"
+	"time"
+
+	types "github.com/dell/gounity/apitypes"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// MetricsNamespace prefixes the names of the client metrics
+const MetricsNamespace = "gounity"
+
+// statusCodeError labels requests that failed without a response
+const statusCodeError = "error"
+
+// ClientMetrics holds the Prometheus metrics of the REST calls made by a client.
+// A nil *ClientMetrics records nothing.
+type ClientMetrics struct {
+	requests         *prometheus.CounterVec
+	duration         *prometheus.HistogramVec
+	inFlight         prometheus.Gauge
+	unityErrors      *prometheus.CounterVec
+	reauthentication *prometheus.CounterVec
+	retries          *prometheus.CounterVec
+}
+
+// NewClientMetrics creates the client metrics and registers them with the registerer.
+// Clients sharing a registerer share the metrics.
+func NewClientMetrics(registerer prometheus.Registerer) (*ClientMetrics, error) {
+	m := &ClientMetrics{
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: MetricsNamespace,
+			Name:      "requests_total",
+			Help:      "Number of REST calls made to Unity by method, route template and status code.",
+		}, []string{"method", "route", "status_code"}),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: MetricsNamespace,
+			Name:      "request_duration_seconds",
+			Help:      "Latency of the REST calls made to Unity by method, route template and status code.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"method", "route", "status_code"}),
+		inFlight: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: MetricsNamespace,
+			Name:      "requests_in_flight",
+			Help:      "Number of REST calls to Unity in progress.",
+		}),
+		unityErrors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: MetricsNamespace,
+			Name:      "unity_errors_total",
+			Help:      "Number of Unity errors by route template and Unity error code.",
+		}, []string{"route", "error_code"}),
+		reauthentication: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: MetricsNamespace,
+			Name:      "reauthentications_total",
+			Help:      "Number of logins made after the session expired, by result.",
+		}, []string{"result"}),
+		retries: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: MetricsNamespace,
+			Name:      "retries_total",
+			Help:      "Number of REST calls retried by method and route template.",
+		}, []string{"method", "route"}),
+	}
+
+	var err error
+	m.requests, err = register(registerer, m.requests)
+	if err == nil {
+		m.duration, err = register(registerer, m.duration)
+	}
+	if err == nil {
+		m.inFlight, err = register(registerer, m.inFlight)
+	}
+	if err == nil {
+		m.unityErrors, err = register(registerer, m.unityErrors)
+	}
+	if err == nil {
+		m.reauthentication, err = register(registerer, m.reauthentication)
+	}
+	if err == nil {
+		m.retries, err = register(registerer, m.retries)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// register registers the collector, returning the already registered one if any
+func register[T prometheus.Collector](registerer prometheus.Registerer, collector T) (T, error) {
+	if err := registerer.Register(collector); err != nil {
+		var are prometheus.AlreadyRegisteredError
+		if errors.As(err, &are) {
+			if existing, ok := are.ExistingCollector.(T); ok {
+				return existing, nil
+			}
+		}
+		return collector, err
+	}
+	return collector, nil
+}
+
+// startRequest records a request in flight
+func (m *ClientMetrics) startRequest() {
+	if m == nil {
+		return
+	}
+	m.inFlight.Inc()
+}
+
+// endRequest records the outcome of a request. statusCode is zero if no response was received.
+func (m *ClientMetrics) endRequest(method, uri string, statusCode int, duration time.Duration, err error) {
+	if m == nil {
+		return
+	}
+	m.inFlight.Dec()
+	route := RouteTemplate(uri)
+	status := statusCodeError
+	if statusCode != 0 {
+		status = strconv.Itoa(statusCode)
+	}
+	m.requests.WithLabelValues(method, route, status).Inc()
+	m.duration.WithLabelValues(method, route, status).Observe(duration.Seconds())
+
+	var unityError *types.Error
+	if errors.As(err, &unityError) && unityError.ErrorContent.ErrorCode != 0 {
+		m.unityErrors.WithLabelValues(route, strconv.Itoa(unityError.ErrorContent.ErrorCode)).Inc()
+	}
+}
+
+// ObserveReauthentication records a login made after the session expired
+func (m *ClientMetrics) ObserveReauthentication(success bool) {
+	if m == nil {
+		return
+	}
+	result := "success"
+	if !success {
+		result = "failure"
+	}
+	m.reauthentication.WithLabelValues(result).Inc()
+}
+
+// ObserveRetry records a retried REST call
+func (m *ClientMetrics) ObserveRetry(method, uri string) {
+	if m == nil {
+		return
+	}
+	m.retries.WithLabelValues(method, RouteTemplate(uri)).Inc()
+}
@@ -0,0 +1,75 @@
+// Copyright © 2025 Dell Inc. or its subsidiaries. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//      http://www.apache.org/licenses/LICENSE-2.0
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClientMetrics(t *testing.T) {
+	ctx := context.Background()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusConflict)
+			_, _ = w.Write([]byte(`{"error": {"errorCode": 108007744, "httpStatusCode": 409, "messages": [{"en-US": "in use"}]}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	registry := prometheus.NewRegistry()
+	c, err := New(ctx, server.URL, ClientOptions{MetricsRegisterer: registry}, false)
+	require.NoError(t, err)
+	// A second client with the same registerer shares the metrics
+	c2, err := New(ctx, server.URL, ClientOptions{MetricsRegisterer: registry}, false)
+	require.NoError(t, err)
+
+	assert.NoError(t, c.DoWithHeaders(ctx, http.MethodGet, "/api/instances/lun/sv_1?fields=id", nil, nil, &map[string]interface{}{}))
+	assert.NoError(t, c2.DoWithHeaders(ctx, http.MethodGet, "/api/instances/lun/sv_2?fields=id", nil, nil, nil))
+	assert.Error(t, c.DoWithHeaders(ctx, http.MethodDelete, "/api/instances/storageResource/sv_1", nil, nil, nil))
+
+	metrics := c.(*client).metrics
+	assert.Same(t, metrics.requests, c2.(*client).metrics.requests)
+	assert.Equal(t, 2.0, testutil.ToFloat64(metrics.requests.WithLabelValues(http.MethodGet, "/api/instances/lun/{id}", "200")))
+	assert.Equal(t, 1.0, testutil.ToFloat64(metrics.requests.WithLabelValues(http.MethodDelete, "/api/instances/storageResource/{id}", "409")))
+	assert.Equal(t, 1.0, testutil.ToFloat64(metrics.unityErrors.WithLabelValues("/api/instances/storageResource/{id}", "108007744")))
+	assert.Equal(t, 0.0, testutil.ToFloat64(metrics.inFlight))
+	assert.Equal(t, 2, testutil.CollectAndCount(metrics.duration))
+
+	metrics.ObserveReauthentication(true)
+	metrics.ObserveReauthentication(false)
+	metrics.ObserveRetry(http.MethodGet, "/api/instances/lun/sv_1")
+	assert.Equal(t, 1.0, testutil.ToFloat64(metrics.reauthentication.WithLabelValues("failure")))
+	assert.Equal(t, 1.0, testutil.ToFloat64(metrics.retries.WithLabelValues(http.MethodGet, "/api/instances/lun/{id}")))
+
+	// Negative case: the request fails without a response
+	c3, err := New(ctx, "http://127.0.0.1:1", ClientOptions{Metrics: metrics}, false)
+	require.NoError(t, err)
+	assert.Error(t, c3.DoWithHeaders(ctx, http.MethodGet, "/api/types/pool/instances", nil, nil, nil))
+	assert.Equal(t, 1.0, testutil.ToFloat64(metrics.requests.WithLabelValues(http.MethodGet, "/api/types/pool/instances", "error")))
+
+	// A nil *ClientMetrics records nothing
+	var noMetrics *ClientMetrics
+	noMetrics.ObserveRetry(http.MethodGet, "/api/types/pool/instances")
+	noMetrics.ObserveReauthentication(true)
+}
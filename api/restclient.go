@@ -23,14 +23,21 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"net"
 	"net/http"
 	"net/http/cookiejar"
 	"net/url"
 	"strings"
+	"sync"
 	"time"
 
 	types "github.com/dell/gounity/apitypes"
 	util "github.com/dell/gounity/gounityutil"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // Header Key constants
@@ -40,6 +47,14 @@ const (
 	HeaderValContentTypeJSON              = "application/json"
 	headerValContentTypeBinaryOctetStream = "binary/octet-stream"
 	HeaderEMCCSRFToken                    = "EMC-CSRF-TOKEN" // #nosec G101
+	HeaderKeyUserAgent                    = "User-Agent"
+)
+
+// Transport defaults, matching those of http.DefaultTransport
+const (
+	DefaultDialTimeout         = 30 * time.Second
+	DefaultTLSHandshakeTimeout = 10 * time.Second
+	DefaultIdleConnTimeout     = 90 * time.Second
 )
 
 var (
@@ -101,14 +116,55 @@ type Client interface {
 
 	// GetToken gets the Auth token for the HTTP client
 	GetToken() string
+
+	// ClearCookies removes the session cookies of the HTTP client
+	ClearCookies()
 }
 
 type client struct {
-	http     *http.Client
-	host     string
-	token    string
-	showHTTP bool
-	debug    bool
+	http         *http.Client
+	jar          *resettableJar
+	host         string
+	token        string
+	userAgent    string
+	interceptors interceptorChain
+	tracer       trace.Tracer
+	metrics      *ClientMetrics
+	readLimiter  *limiter
+	writeLimiter *limiter
+	debug        bool
+}
+
+// resettableJar is a cookie jar that can be emptied while requests are in flight
+type resettableJar struct {
+	mutex sync.RWMutex
+	jar   http.CookieJar
+}
+
+func newResettableJar() *resettableJar {
+	j := &resettableJar{}
+	j.Reset()
+	return j
+}
+
+func (j *resettableJar) SetCookies(u *url.URL, cookies []*http.Cookie) {
+	j.mutex.RLock()
+	defer j.mutex.RUnlock()
+	j.jar.SetCookies(u, cookies)
+}
+
+func (j *resettableJar) Cookies(u *url.URL) []*http.Cookie {
+	j.mutex.RLock()
+	defer j.mutex.RUnlock()
+	return j.jar.Cookies(u)
+}
+
+// Reset replaces the jar with an empty one
+func (j *resettableJar) Reset() {
+	cookieJar, _ := cookiejar.New(&cookiejar.Options{PublicSuffixList: nil})
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+	j.jar = cookieJar
 }
 
 // ClientOptions are options for the API client.
@@ -122,6 +178,65 @@ type ClientOptions struct {
 	// ShowHTTP is a flag that indicates whether or not HTTP requests and
 	// responses should be logged to stdout
 	ShowHTTP bool
+
+	// CACertificates is a PEM bundle of certificate authorities trusted in addition to the system pool.
+	CACertificates []byte
+
+	// CACertificatesFile is the path of a PEM bundle of certificate authorities trusted in addition to the system pool.
+	CACertificatesFile string
+
+	// PinnedSPKIFingerprints are hex SHA-256 fingerprints of the SubjectPublicKeyInfo of trusted certificates.
+	// If set, a certificate of the verified chain must match one of them. Combined with Insecure, the chain is
+	// not verified and only the leaf certificate of the server may match, which suits arrays with self-signed certificates.
+	PinnedSPKIFingerprints []string
+
+	// ClientCertificate is presented to the server for mutual TLS. It takes precedence over ClientCertFile and ClientKeyFile.
+	ClientCertificate *tls.Certificate
+
+	// ClientCertFile and ClientKeyFile are the paths of the PEM certificate and key presented for mutual TLS.
+	ClientCertFile string
+	ClientKeyFile  string
+
+	// ServerName overrides the name used to verify the server certificate, e.g. when the endpoint is an IP address.
+	ServerName string
+
+	// MinTLSVersion is the minimum TLS version, such as tls.VersionTLS13. It defaults to TLS 1.2 unless Insecure is set.
+	MinTLSVersion uint16
+
+	// DialTimeout, TLSHandshakeTimeout and IdleConnTimeout configure the transport.
+	// Zero values use DefaultDialTimeout, DefaultTLSHandshakeTimeout and DefaultIdleConnTimeout.
+	DialTimeout         time.Duration
+	TLSHandshakeTimeout time.Duration
+	IdleConnTimeout     time.Duration
+
+	// MaxConnsPerHost limits the number of connections to the array. Zero means no limit.
+	MaxConnsPerHost int
+
+	// Proxy returns the proxy of a request, e.g. http.ProxyFromEnvironment. Requests are not proxied if it is nil.
+	Proxy func(*http.Request) (*url.URL, error)
+
+	// Transport replaces the transport built from the TLS, timeout, connection and proxy options.
+	Transport http.RoundTripper
+
+	// UserAgent is sent with every request if set.
+	UserAgent string
+
+	// Interceptors observe or alter every request, in the given order.
+	Interceptors []Interceptor
+
+	// TracerProvider provides the tracer of the request spans. The global provider is used if it is nil.
+	TracerProvider trace.TracerProvider
+
+	// MetricsRegisterer registers the Prometheus metrics of the client. No metrics are recorded if it is nil.
+	MetricsRegisterer prometheus.Registerer
+
+	// Metrics are the metrics recorded by the client, created from MetricsRegisterer if nil.
+	Metrics *ClientMetrics
+
+	// ReadLimit and WriteLimit bound the concurrency and rate of GET and mutating requests separately,
+	// so that a flood of reads does not delay changes and the other way round.
+	ReadLimit  RequestLimit
+	WriteLimit RequestLimit
 }
 
 // New returns a new API client.
@@ -132,46 +247,72 @@ func New(_ context.Context, host string, opts ClientOptions, debug bool) (Client
 
 	host = strings.Replace(host, "/api", "", 1)
 
-	cookieJar, _ := cookiejar.New(&cookiejar.Options{PublicSuffixList: nil})
+	metrics := opts.Metrics
+	if metrics == nil && opts.MetricsRegisterer != nil {
+		var err error
+		if metrics, err = NewClientMetrics(opts.MetricsRegisterer); err != nil {
+			return nil, fmt.Errorf("unable to register client metrics: %v", err)
+		}
+	}
 
 	c := &client{
-		http:  &http.Client{},
-		host:  host,
-		debug: debug,
+		http:      &http.Client{},
+		jar:       newResettableJar(),
+		host:      host,
+		userAgent: opts.UserAgent,
+		tracer:    Tracer(opts.TracerProvider),
+		metrics:   metrics,
+		debug:     debug,
+
+		readLimiter:  newLimiter("read", opts.ReadLimit),
+		writeLimiter: newLimiter("write", opts.WriteLimit),
 	}
 
 	if opts.Timeout != 0 {
 		c.http.Timeout = opts.Timeout
 	}
 
-	if opts.Insecure { // #nosec G402
-		c.http.Transport = &http.Transport{
-			TLSClientConfig: &tls.Config{
-				InsecureSkipVerify: true,
-				CipherSuites:       util.GetSecuredCipherSuites(),
-			},
-		}
+	if opts.Transport != nil {
+		c.http.Transport = opts.Transport
 	} else {
-		pool, err := systemCertPoolFunc()
+		transport, err := newTransport(opts)
 		if err != nil {
-			return nil, errSysCerts
-		}
-		c.http.Transport = &http.Transport{
-			TLSClientConfig: &tls.Config{
-				RootCAs:            pool,
-				InsecureSkipVerify: false,
-				CipherSuites:       util.GetSecuredCipherSuites(),
-				MinVersion:         tls.VersionTLS12,
-			},
+			return nil, err
 		}
+		c.http.Transport = transport
 	}
-	c.http.Jar = cookieJar
+	c.http.Jar = c.jar
 	if opts.ShowHTTP {
-		c.showHTTP = true
+		c.interceptors = append(c.interceptors, ShowHTTPInterceptor())
 	}
+	c.interceptors = append(c.interceptors, opts.Interceptors...)
 	return c, nil
 }
 
+// newTransport builds the HTTP transport from the TLS, timeout, connection and proxy options
+func newTransport(opts ClientOptions) (*http.Transport, error) {
+	tlsConfig, err := newTLSConfig(opts)
+	if err != nil {
+		return nil, err
+	}
+	dialer := &net.Dialer{Timeout: durationOrDefault(opts.DialTimeout, DefaultDialTimeout), KeepAlive: 30 * time.Second}
+	return &http.Transport{
+		TLSClientConfig:     tlsConfig,
+		Proxy:               opts.Proxy,
+		DialContext:         dialer.DialContext,
+		TLSHandshakeTimeout: durationOrDefault(opts.TLSHandshakeTimeout, DefaultTLSHandshakeTimeout),
+		IdleConnTimeout:     durationOrDefault(opts.IdleConnTimeout, DefaultIdleConnTimeout),
+		MaxConnsPerHost:     opts.MaxConnsPerHost,
+	}, nil
+}
+
+func durationOrDefault(d, defaultDuration time.Duration) time.Duration {
+	if d == 0 {
+		return defaultDuration
+	}
+	return d
+}
+
 // Makes a GET call to the Unity REST API Server with the given path & headers
 func (c *client) Get(ctx context.Context, path string, headers map[string]string, resp interface{}) error {
 	return c.DoWithHeaders(ctx, http.MethodGet, path, headers, nil, resp)
@@ -287,32 +428,75 @@ func (c *client) DoAndGetResponseBody(ctx context.Context, method, uri string, h
 		}
 		req.Header.Add(header, value)
 	}
+	if req.Header.Get(HeaderKeyAccept) == "" {
+		req.Header.Set(HeaderKeyAccept, HeaderValContentTypeJSON)
+	}
+	if c.userAgent != "" && req.Header.Get(HeaderKeyUserAgent) == "" {
+		req.Header.Set(HeaderKeyUserAgent, c.userAgent)
+	}
 
 	// set the auth token for POST and DELETE methods only
 	if (method == "POST" || method == "DELETE") && c.token != "" {
 		req.Header.Set(HeaderEMCCSRFToken, c.token)
 	}
 
-	if c.showHTTP {
-		logRequest(ctx, req, c.doLog)
-	}
+	route := RouteTemplate(uri)
+	ctx, span := c.getTracer().Start(ctx, method+" "+route,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(AttributeHTTPMethod.String(method), AttributeURLTemplate.String(route), AttributeServerAddress.String(u.Hostname())))
+	defer span.End()
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(req.Header))
 
-	// send the request
-	req = req.WithContext(ctx)
-	if res, err = c.http.Do(req); err != nil {
+	requestLimiter := c.readLimiter
+	if isMutating(method) {
+		requestLimiter = c.writeLimiter
+	}
+	release, err := requestLimiter.acquire(ctx, method, uri)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
 		return nil, err
 	}
 
-	if c.showHTTP {
-		logResponse(ctx, res, c.doLog)
+	// send the request through the interceptors
+	ireq := &Request{Method: method, URI: uri, Header: req.Header, Body: body, HTTPRequest: req}
+	ctx, n, err := c.interceptors.before(ctx, ireq)
+	start := time.Now()
+	if err == nil {
+		req = req.WithContext(ctx)
+		ireq.HTTPRequest = req
+		res, err = c.http.Do(req)
+	}
+	iresp := &Response{Duration: time.Since(start), Err: err, HTTPResponse: res}
+	if res != nil {
+		iresp.StatusCode = res.StatusCode
+		iresp.Header = res.Header
+	}
+	c.interceptors.after(ctx, n, ireq, iresp)
+	if err != nil {
+		release()
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+	res.Body = &releaseOnClose{ReadCloser: res.Body, release: release}
+	span.SetAttributes(AttributeHTTPStatusCode.Int(res.StatusCode))
+	if res.StatusCode >= 400 {
+		span.SetStatus(codes.Error, http.StatusText(res.StatusCode))
 	}
 
 	log.Debugf("Response code:%d for url: %s", res.StatusCode, uri)
 	return res, err
 }
 
-func (c *client) DoWithHeaders(ctx context.Context, method, uri string, headers map[string]string, body, resp interface{}) error {
+func (c *client) DoWithHeaders(ctx context.Context, method, uri string, headers map[string]string, body, resp interface{}) (err error) {
 	log := util.GetRunIDLogger(ctx)
+	start := time.Now()
+	statusCode := 0
+	c.metrics.startRequest()
+	defer func() {
+		c.metrics.endRequest(method, uri, statusCode, time.Since(start), err)
+	}()
 	if body != nil {
 		data, _ := json.Marshal(body)
 		strBody := strings.ReplaceAll(string(data), "\"", "")
@@ -323,6 +507,7 @@ func (c *client) DoWithHeaders(ctx context.Context, method, uri string, headers
 		return fmt.Errorf("Error while receiving response for url: %s error: %v", uri, err)
 	}
 	defer res.Body.Close()
+	statusCode = res.StatusCode
 
 	// parse the response
 	switch {
@@ -354,6 +539,14 @@ func (c *client) DoWithHeaders(ctx context.Context, method, uri string, headers
 	return nil
 }
 
+// getTracer returns the tracer of the client, or the global one for clients not built by New
+func (c *client) getTracer() trace.Tracer {
+	if c.tracer == nil {
+		return Tracer(nil)
+	}
+	return c.tracer
+}
+
 func (c *client) SetToken(token string) {
 	c.token = token
 }
@@ -362,6 +555,12 @@ func (c *client) GetToken() string {
 	return c.token
 }
 
+func (c *client) ClearCookies() {
+	if c.jar != nil {
+		c.jar.Reset()
+	}
+}
+
 func (c *client) ParseJSONError(ctx context.Context, r *http.Response) error {
 	log := util.GetRunIDLogger(ctx)
 	jsonError := &types.Error{}
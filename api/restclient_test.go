@@ -21,7 +21,9 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"testing"
+	"time"
 
 	types "github.com/dell/gounity/apitypes"
 	"github.com/stretchr/testify/assert"
@@ -50,10 +52,10 @@ func TestDoAndGetResponseBody(t *testing.T) {
 	c.SetToken("token")
 	token := c.GetToken()
 	c = &client{
-		host:     "https://example.com",
-		http:     http.DefaultClient,
-		showHTTP: true,
-		token:    token,
+		host:         "https://example.com",
+		http:         http.DefaultClient,
+		interceptors: interceptorChain{ShowHTTPInterceptor()},
+		token:        token,
 	}
 	ctx := context.Background()
 	// Create a mock request body
@@ -348,3 +350,42 @@ func TestClientDo(t *testing.T) {
 	err := c.Do(context.Background(), http.MethodGet, c.host, nil, nil)
 	assert.Error(t, err)
 }
+
+func TestClearCookies(t *testing.T) {
+	ctx := context.Background()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, err := r.Cookie("mod_sec_emc"); err != nil {
+			http.SetCookie(w, &http.Cookie{Name: "mod_sec_emc", Value: "session", Path: "/"})
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	c, err := New(ctx, server.URL, ClientOptions{}, false)
+	require.NoError(t, err)
+	_, err = c.DoAndGetResponseBody(ctx, http.MethodGet, "/api/types/loginSessionInfo", nil, nil)
+	require.NoError(t, err)
+	u, _ := url.Parse(server.URL)
+	assert.Len(t, c.(*client).jar.Cookies(u), 1)
+
+	c.ClearCookies()
+	assert.Empty(t, c.(*client).jar.Cookies(u))
+}
+
+func TestNewTransport(t *testing.T) {
+	ctx := context.Background()
+	c, err := New(ctx, "https://example.com", ClientOptions{MaxConnsPerHost: 4, IdleConnTimeout: time.Second, UserAgent: "gounity"}, false)
+	require.NoError(t, err)
+	transport := c.(*client).http.Transport.(*http.Transport)
+	assert.Equal(t, 4, transport.MaxConnsPerHost)
+	assert.Equal(t, time.Second, transport.IdleConnTimeout)
+	assert.Equal(t, DefaultTLSHandshakeTimeout, transport.TLSHandshakeTimeout)
+	assert.Nil(t, transport.Proxy)
+	assert.Equal(t, "gounity", c.(*client).userAgent)
+
+	// A custom transport is used as is
+	custom := &http.Transport{}
+	c, err = New(ctx, "https://example.com", ClientOptions{Transport: custom}, false)
+	require.NoError(t, err)
+	assert.Same(t, custom, c.(*client).http.Transport)
+}
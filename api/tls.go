@@ -0,0 +1,132 @@
+/*
+ Copyright © 2025 Dell Inc. or its subsidiaries. All Rights Reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package api
+
+import (
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	util "github.com/dell/gounity/gounityutil"
+)
+
+var errPinMismatch = errors.New("the server certificate does not match the pinned SPKI fingerprints")
+
+// SPKIFingerprint returns the lowercase hex SHA-256 fingerprint of the SubjectPublicKeyInfo of the certificate.
+// Unlike the certificate fingerprint, it does not change when the array certificate is renewed with the same key.
+func SPKIFingerprint(cert *x509.Certificate) string {
+	sum := sha256.Sum256(cert.RawSubjectPublicKeyInfo)
+	return hex.EncodeToString(sum[:])
+}
+
+// normalizeFingerprint removes colons and spaces from a hex fingerprint and lowercases it
+func normalizeFingerprint(fingerprint string) string {
+	return strings.ToLower(strings.NewReplacer(":", "", " ", "").Replace(fingerprint))
+}
+
+// newTLSConfig builds the TLS configuration of the HTTP client from the options
+func newTLSConfig(opts ClientOptions) (*tls.Config, error) {
+	config := &tls.Config{
+		CipherSuites: util.GetSecuredCipherSuites(),
+		ServerName:   opts.ServerName,
+		MinVersion:   opts.MinTLSVersion,
+	}
+
+	if opts.Insecure { // #nosec G402
+		config.InsecureSkipVerify = true
+	} else {
+		if config.MinVersion == 0 {
+			config.MinVersion = tls.VersionTLS12
+		}
+		pool, err := rootCAs(opts)
+		if err != nil {
+			return nil, err
+		}
+		config.RootCAs = pool
+	}
+
+	if opts.ClientCertificate != nil {
+		config.Certificates = []tls.Certificate{*opts.ClientCertificate}
+	} else if opts.ClientCertFile != "" || opts.ClientKeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(opts.ClientCertFile, opts.ClientKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("unable to load client certificate: %v", err)
+		}
+		config.Certificates = []tls.Certificate{cert}
+	}
+
+	if len(opts.PinnedSPKIFingerprints) > 0 {
+		pins := make([]string, 0, len(opts.PinnedSPKIFingerprints))
+		for _, pin := range opts.PinnedSPKIFingerprints {
+			pins = append(pins, normalizeFingerprint(pin))
+		}
+		config.VerifyConnection = func(cs tls.ConnectionState) error {
+			return verifyPins(cs, pins)
+		}
+	}
+	return config, nil
+}
+
+// verifyPins checks that a certificate trusted for the connection matches one of the pins. When the chain was
+// verified, any certificate of a verified chain may match, so that an intermediate CA can be pinned. Otherwise
+// only the leaf may match: the rest of the presented chain is not bound to the server key, and a man in the
+// middle could append the pinned certificate to its own.
+func verifyPins(cs tls.ConnectionState, pins []string) error {
+	if len(cs.VerifiedChains) > 0 {
+		for _, chain := range cs.VerifiedChains {
+			for _, cert := range chain {
+				if slices.Contains(pins, SPKIFingerprint(cert)) {
+					return nil
+				}
+			}
+		}
+		return errPinMismatch
+	}
+	if len(cs.PeerCertificates) > 0 && slices.Contains(pins, SPKIFingerprint(cs.PeerCertificates[0])) {
+		return nil
+	}
+	return errPinMismatch
+}
+
+// rootCAs returns the system certificate pool with the configured CA bundle added.
+// If the system pool is not available, only the CA bundle is trusted.
+func rootCAs(opts ClientOptions) (*x509.CertPool, error) {
+	caCertificates := opts.CACertificates
+	if opts.CACertificatesFile != "" {
+		data, err := os.ReadFile(opts.CACertificatesFile)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read CA bundle %s: %v", opts.CACertificatesFile, err)
+		}
+		caCertificates = append(append([]byte(nil), caCertificates...), data...)
+	}
+
+	pool, err := systemCertPoolFunc()
+	if err != nil {
+		if len(caCertificates) == 0 {
+			return nil, errSysCerts
+		}
+		pool = x509.NewCertPool()
+	}
+	if len(caCertificates) > 0 && !pool.AppendCertsFromPEM(caCertificates) {
+		return nil, errors.New("no valid PEM certificate found in the CA bundle")
+	}
+	return pool, nil
+}
@@ -0,0 +1,165 @@
+// Copyright © 2025 Dell Inc. or its subsidiaries. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//      http://www.apache.org/licenses/LICENSE-2.0
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package api
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTLSOptions(t *testing.T) {
+	ctx := context.Background()
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+	cert := server.Certificate()
+	caPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw})
+	fingerprint := SPKIFingerprint(cert)
+
+	get := func(opts ClientOptions) error {
+		c, err := New(ctx, server.URL, opts, false)
+		if err != nil {
+			return err
+		}
+		_, err = c.DoAndGetResponseBody(ctx, http.MethodGet, "/api/types/basicSystemInfo/instances", nil, nil)
+		return err
+	}
+
+	// The test server certificate is not trusted by the system pool
+	assert.Error(t, get(ClientOptions{}))
+
+	assert.NoError(t, get(ClientOptions{CACertificates: caPEM, ServerName: "example.com", MinTLSVersion: tls.VersionTLS13}))
+
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	require.NoError(t, os.WriteFile(caFile, caPEM, 0o600))
+	assert.NoError(t, get(ClientOptions{CACertificatesFile: caFile, PinnedSPKIFingerprints: []string{fingerprint}}))
+
+	// Pinning alone trusts a self-signed certificate; colons and case are ignored
+	assert.NoError(t, get(ClientOptions{Insecure: true, PinnedSPKIFingerprints: []string{strings.ToUpper(fingerprint[:2]) + ":" + fingerprint[2:]}}))
+
+	// A CA bundle is used even if the system pool is not available
+	originalFunc := systemCertPoolFunc
+	defer func() { systemCertPoolFunc = originalFunc }()
+	systemCertPoolFunc = func() (*x509.CertPool, error) {
+		return nil, errors.New("mock system cert pool error")
+	}
+	assert.NoError(t, get(ClientOptions{CACertificates: caPEM}))
+	systemCertPoolFunc = originalFunc
+
+	// Negative cases
+	err := get(ClientOptions{Insecure: true, PinnedSPKIFingerprints: []string{strings.Repeat("0", 64)}})
+	assert.ErrorContains(t, err, errPinMismatch.Error())
+	assert.Error(t, get(ClientOptions{CACertificates: caPEM, ServerName: "unity.example.org"}))
+	_, err = New(ctx, server.URL, ClientOptions{CACertificates: []byte("not a certificate")}, false)
+	assert.Error(t, err)
+	_, err = New(ctx, server.URL, ClientOptions{CACertificatesFile: "/nonexistent/ca.pem"}, false)
+	assert.Error(t, err)
+	_, err = New(ctx, server.URL, ClientOptions{ClientCertFile: "/nonexistent/cert.pem", ClientKeyFile: "/nonexistent/key.pem"}, false)
+	assert.Error(t, err)
+}
+
+func TestClientCertificate(t *testing.T) {
+	ctx := context.Background()
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if len(r.TLS.PeerCertificates) == 0 {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	server.TLS = &tls.Config{ClientAuth: tls.RequestClientCert}
+	server.StartTLS()
+	defer server.Close()
+
+	// The test server certificate doubles as client certificate
+	clientCert := server.TLS.Certificates[0]
+	c, err := New(ctx, server.URL, ClientOptions{Insecure: true, ClientCertificate: &clientCert}, false)
+	require.NoError(t, err)
+	res, err := c.DoAndGetResponseBody(ctx, http.MethodGet, "/api/types/basicSystemInfo/instances", nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+}
+
+// newSelfSignedCertificate returns a self-signed certificate for 127.0.0.1 with a new key
+func newSelfSignedCertificate(t *testing.T, commonName string) tls.Certificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		DNSNames:     []string{"localhost"},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	leaf, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key, Leaf: leaf}
+}
+
+func TestPinnedLeafCertificate(t *testing.T) {
+	ctx := context.Background()
+	array := newSelfSignedCertificate(t, "array")
+	forged := newSelfSignedCertificate(t, "attacker")
+
+	// The attacker presents its own leaf with the public certificate of the array appended to the chain
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	server.TLS = &tls.Config{Certificates: []tls.Certificate{{
+		Certificate: [][]byte{forged.Certificate[0], array.Certificate[0]},
+		PrivateKey:  forged.PrivateKey,
+	}}}
+	server.StartTLS()
+	defer server.Close()
+
+	get := func(opts ClientOptions) error {
+		c, err := New(ctx, server.URL, opts, false)
+		if err != nil {
+			return err
+		}
+		_, err = c.DoAndGetResponseBody(ctx, http.MethodGet, "/api/types/basicSystemInfo/instances", nil, nil)
+		return err
+	}
+
+	assert.NoError(t, get(ClientOptions{Insecure: true, PinnedSPKIFingerprints: []string{SPKIFingerprint(forged.Leaf)}}))
+
+	// Negative cases
+	err := get(ClientOptions{Insecure: true, PinnedSPKIFingerprints: []string{SPKIFingerprint(array.Leaf)}})
+	assert.ErrorContains(t, err, errPinMismatch.Error())
+	assert.ErrorIs(t, verifyPins(tls.ConnectionState{}, []string{SPKIFingerprint(array.Leaf)}), errPinMismatch)
+	chain := []*x509.Certificate{forged.Leaf, array.Leaf}
+	assert.NoError(t, verifyPins(tls.ConnectionState{PeerCertificates: chain, VerifiedChains: [][]*x509.Certificate{chain}}, []string{SPKIFingerprint(array.Leaf)}))
+	assert.ErrorIs(t, verifyPins(tls.ConnectionState{PeerCertificates: chain, VerifiedChains: [][]*x509.Certificate{chain[:1]}}, []string{SPKIFingerprint(array.Leaf)}), errPinMismatch)
+}
@@ -0,0 +1,61 @@
+/*
+ Copyright © 2025 Dell Inc. or its subsidiaries. All Rights Reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package api
+
+import (
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TracerName is the name of the OpenTelemetry tracer of gounity
+const TracerName = "github.com/dell/gounity"
+
+// Span attribute keys
+const (
+	AttributeHTTPMethod      = attribute.Key("http.request.method")
+	AttributeHTTPStatusCode  = attribute.Key("http.response.status_code")
+	AttributeURLTemplate     = attribute.Key("url.template")
+	AttributeServerAddress   = attribute.Key("server.address")
+	AttributeUnityErrorCode  = attribute.Key("unity.error_code")
+	AttributeUnityRetryCount = attribute.Key("unity.retry_count")
+)
+
+// Tracer returns the gounity tracer of the provider, or of the global provider if tp is nil
+func Tracer(tp trace.TracerProvider) trace.Tracer {
+	if tp == nil {
+		tp = otel.GetTracerProvider()
+	}
+	return tp.Tracer(TracerName)
+}
+
+// RouteTemplate returns the path of a Unity URI with the query removed and the resource ID or name replaced
+// by a placeholder, such as /api/instances/lun/{id} or /api/instances/host/name:{name}, so that it can be
+// used as a low cardinality span name or metric label.
+func RouteTemplate(uri string) string {
+	path, _, _ := strings.Cut(uri, "?")
+	segments := strings.Split(path, "/")
+	// segments of /api/instances/{type}/{id}/... are "", "api", "instances", type, id, ...
+	if len(segments) >= 5 && segments[1] == "api" && segments[2] == "instances" {
+		if strings.HasPrefix(segments[4], "name:") {
+			segments[4] = "name:{name}"
+		} else {
+			segments[4] = "{id}"
+		}
+	}
+	return strings.Join(segments, "/")
+}
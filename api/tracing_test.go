@@ -0,0 +1,58 @@
+// Copyright © 2025 Dell Inc. or its subsidiaries. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//      http://www.apache.org/licenses/LICENSE-2.0
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package api
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestRouteTemplate(t *testing.T) {
+	tests := map[string]string{
+		"/api/instances/lun/sv_1?fields=id,name":                 "/api/instances/lun/{id}",
+		"/api/instances/storageResource/sv_1/action/modifyLun":   "/api/instances/storageResource/{id}/action/modifyLun",
+		"/api/instances/host/name:node-1?fields=id":              "/api/instances/host/name:{name}",
+		"/api/types/lun/instances?fields=id&filter=name lk 'a%'": "/api/types/lun/instances",
+		"/api/types/storageResource/action/createLun":            "/api/types/storageResource/action/createLun",
+		"/api/instances": "/api/instances",
+	}
+	for uri, expected := range tests {
+		assert.Equal(t, expected, RouteTemplate(uri), uri)
+	}
+}
+
+func TestRequestSpan(t *testing.T) {
+	ctx := context.Background()
+	exporter := tracetest.NewInMemoryExporter()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+
+	// Negative case: the request fails
+	c, err := New(ctx, "http://127.0.0.1:1", ClientOptions{TracerProvider: tp}, false)
+	require.NoError(t, err)
+	_, err = c.DoAndGetResponseBody(ctx, http.MethodGet, "/api/instances/lun/sv_1", nil, nil)
+	assert.Error(t, err)
+
+	spans := exporter.GetSpans()
+	require.Len(t, spans, 1)
+	assert.Equal(t, "GET /api/instances/lun/{id}", spans[0].Name)
+	assert.Equal(t, codes.Error, spans[0].Status.Code)
+	assert.Len(t, spans[0].Events, 1)
+}
@@ -114,10 +114,27 @@ type FsExpandModifyParam struct {
 	FsParameters *FsExpandParameters `json:"fsParameters"`
 }
 
-// FsModifyParameters Struct to modify Filesystem parameters
+// FsModifyParameters Struct to modify Filesystem parameters. Fields left empty are not modified.
 type FsModifyParameters struct {
-	NFSShares   *[]NFSShareCreateParam `json:"nfsShareCreate,omitempty"`
-	Description string                 `json:"description,omitempty"`
+	NFSShares              *[]NFSShareCreateParam    `json:"nfsShareCreate,omitempty"`
+	Description            string                    `json:"description,omitempty"`
+	FsParameters           *FsFastVPModifyParameters `json:"fsParameters,omitempty"`
+	SnapScheduleParameters *SnapScheduleParameters   `json:"snapScheduleParameters,omitempty"`
+}
+
+// FsFastVPModifyParameters Struct to capture the FAST VP parameters of a Filesystem modify
+type FsFastVPModifyParameters struct {
+	FastVPParameters *FastVPParameters `json:"fastVPParameters,omitempty"`
+}
+
+// SnapScheduleParameters Struct to capture the snapshot schedule assigned to a storage resource
+type SnapScheduleParameters struct {
+	SnapSchedule *SnapScheduleID `json:"snapSchedule,omitempty"`
+}
+
+// SnapScheduleID Struct to capture the ID of a snapshot schedule
+type SnapScheduleID struct {
+	ID string `json:"id"`
 }
 
 // NFSShareCreateParam Struct to capture NFS Share Create parameters
@@ -228,6 +245,13 @@ type LunModifyParam struct {
 	LunParameters *LunParameters `json:"lunParameters"`
 }
 
+// VolumeModifyParam Struct to capture the Lun modify Params. Fields left empty are not modified.
+type VolumeModifyParam struct {
+	Description            string                  `json:"description,omitempty"`
+	LunParameters          *LunParameters          `json:"lunParameters,omitempty"`
+	SnapScheduleParameters *SnapScheduleParameters `json:"snapScheduleParameters,omitempty"`
+}
+
 // LunExpandModifyParam Struct to capture Lun expand modify parameters
 type LunExpandModifyParam struct {
 	LunParameters *LunExpandParameters `json:"lunParameters"`
@@ -280,5 +304,54 @@ type CreateLunThinCloneParam struct {
 	Name          string             `json:"name"`
 }
 
+// AlertModifyParam struct to capture alert modify parameters
+type AlertModifyParam struct {
+	IsAcknowledged bool `json:"isAcknowledged"`
+}
+
 // InitiatorType is string Type
 type InitiatorType string
+
+// DiskGroupID Struct to capture Disk group ID
+type DiskGroupID struct {
+	ID string `json:"id"`
+}
+
+// RaidGroupParameters Struct to capture the disks of a disk group and the RAID configuration used to add them to a pool
+type RaidGroupParameters struct {
+	DiskGroup   *DiskGroupID `json:"dskGroup"`
+	NumDisks    int          `json:"numDisks"`
+	RaidType    int          `json:"raidType"`
+	StripeWidth int          `json:"stripeWidth,omitempty"`
+}
+
+// PoolCreateParam Struct to capture the Pool create Params
+type PoolCreateParam struct {
+	Name                   string                `json:"name"`
+	Description            string                `json:"description,omitempty"`
+	AddRaidGroupParameters []RaidGroupParameters `json:"addRaidGroupParameters"`
+}
+
+// PoolModifyParam Struct to capture the Pool modify Params. Fields left empty are not modified.
+type PoolModifyParam struct {
+	Name                          string                `json:"name,omitempty"`
+	Description                   string                `json:"description,omitempty"`
+	AddRaidGroupParameters        []RaidGroupParameters `json:"addRaidGroupParameters,omitempty"`
+	AlertThreshold                int                   `json:"alertThreshold,omitempty"`
+	IsFASTCacheEnabled            *bool                 `json:"isFASTCacheEnabled,omitempty"`
+	IsFASTVpScheduleEnabled       *bool                 `json:"isFASTVpScheduleEnabled,omitempty"`
+	IsSnapHarvestEnabled          *bool                 `json:"isSnapHarvestEnabled,omitempty"`
+	SnapSpaceHarvestHighThreshold float64               `json:"snapSpaceHarvestHighThreshold,omitempty"`
+	SnapSpaceHarvestLowThreshold  float64               `json:"snapSpaceHarvestLowThreshold,omitempty"`
+}
+
+// LogoutParam Struct to capture the loginSessionInfo logout action Params
+type LogoutParam struct {
+	LocalCleanupOnly bool `json:"localCleanupOnly"`
+}
+
+// SnapScheduleCreateParam Struct to capture the Snapshot Schedule create Params
+type SnapScheduleCreateParam struct {
+	Name  string             `json:"name"`
+	Rules []SnapScheduleRule `json:"rules"`
+}
@@ -145,6 +145,133 @@ type Content struct {
 	EarliestAPIVersion string `json:"earliestApiVersion"`
 }
 
+// ListSystems struct to capture system list
+type ListSystems struct {
+	Systems []System `json:"entries"`
+}
+
+// System struct to capture system object
+type System struct {
+	SystemContent SystemContent `json:"content"`
+}
+
+// SystemContent struct to capture system parameters
+type SystemContent struct {
+	ID           string        `json:"id"`
+	Name         string        `json:"name,omitempty"`
+	Model        string        `json:"model,omitempty"`
+	SerialNumber string        `json:"serialNumber,omitempty"`
+	Platform     string        `json:"platform,omitempty"`
+	Health       HealthContent `json:"health,omitempty"`
+}
+
+// ListStorageProcessors struct to capture storage processor list
+type ListStorageProcessors struct {
+	StorageProcessors []StorageProcessor `json:"entries"`
+}
+
+// StorageProcessor struct to capture storage processor object
+type StorageProcessor struct {
+	StorageProcessorContent StorageProcessorContent `json:"content"`
+}
+
+// StorageProcessorContent struct to capture storage processor parameters
+type StorageProcessorContent struct {
+	ID               string        `json:"id"`
+	Name             string        `json:"name,omitempty"`
+	Health           HealthContent `json:"health,omitempty"`
+	NeedsReplacement bool          `json:"needsReplacement"`
+	SlotNumber       int           `json:"slotNumber"`
+	EmcPartNumber    string        `json:"emcPartNumber,omitempty"`
+	EmcSerialNumber  string        `json:"emcSerialNumber,omitempty"`
+	Manufacturer     string        `json:"manufacturer,omitempty"`
+	ModelName        string        `json:"modelName,omitempty"`
+	MemorySize       uint64        `json:"memorySize,omitempty"`
+	IsRescueMode     bool          `json:"isRescueMode"`
+	ParentDpe        ResourceRef   `json:"parentDpe,omitempty"`
+}
+
+// ListEnclosures struct to capture DPE or DAE list
+type ListEnclosures struct {
+	Enclosures []Enclosure `json:"entries"`
+}
+
+// Enclosure struct to capture DPE or DAE object
+type Enclosure struct {
+	EnclosureContent EnclosureContent `json:"content"`
+}
+
+// EnclosureContent struct to capture DPE or DAE parameters
+type EnclosureContent struct {
+	ID               string        `json:"id"`
+	Name             string        `json:"name,omitempty"`
+	Health           HealthContent `json:"health,omitempty"`
+	NeedsReplacement bool          `json:"needsReplacement"`
+	SlotNumber       int           `json:"slotNumber"`
+	EmcPartNumber    string        `json:"emcPartNumber,omitempty"`
+	EmcSerialNumber  string        `json:"emcSerialNumber,omitempty"`
+	Manufacturer     string        `json:"manufacturer,omitempty"`
+	Model            string        `json:"model,omitempty"`
+}
+
+// ListDisks struct to capture disk list
+type ListDisks struct {
+	Disks []Disk `json:"entries"`
+}
+
+// Disk struct to capture disk object
+type Disk struct {
+	DiskContent DiskContent `json:"content"`
+}
+
+// DiskContent struct to capture disk parameters
+type DiskContent struct {
+	ID               string        `json:"id"`
+	Name             string        `json:"name,omitempty"`
+	Health           HealthContent `json:"health,omitempty"`
+	NeedsReplacement bool          `json:"needsReplacement"`
+	SlotNumber       int           `json:"slotNumber"`
+	EmcPartNumber    string        `json:"emcPartNumber,omitempty"`
+	EmcSerialNumber  string        `json:"emcSerialNumber,omitempty"`
+	Manufacturer     string        `json:"manufacturer,omitempty"`
+	Model            string        `json:"model,omitempty"`
+	Size             uint64        `json:"size,omitempty"`
+	RawSize          uint64        `json:"rawSize,omitempty"`
+	TierType         int           `json:"tierType"`
+	DiskTechnology   int           `json:"diskTechnology"`
+	Pool             Pool          `json:"pool,omitempty"`
+	IsInUse          bool          `json:"isInUse"`
+}
+
+// ListHardwareComponents struct to capture power supply, battery or fan list
+type ListHardwareComponents struct {
+	HardwareComponents []HardwareComponent `json:"entries"`
+}
+
+// HardwareComponent struct to capture power supply, battery or fan object
+type HardwareComponent struct {
+	HardwareComponentContent HardwareComponentContent `json:"content"`
+}
+
+// HardwareComponentContent struct to capture power supply, battery or fan parameters
+type HardwareComponentContent struct {
+	ID               string        `json:"id"`
+	Name             string        `json:"name,omitempty"`
+	Health           HealthContent `json:"health,omitempty"`
+	NeedsReplacement bool          `json:"needsReplacement"`
+	SlotNumber       int           `json:"slotNumber"`
+	EmcPartNumber    string        `json:"emcPartNumber,omitempty"`
+	EmcSerialNumber  string        `json:"emcSerialNumber,omitempty"`
+	Manufacturer     string        `json:"manufacturer,omitempty"`
+	Model            string        `json:"model,omitempty"`
+	Parent           ResourceRef   `json:"parent,omitempty"`
+}
+
+// ListHosts struct to capture the response of host list
+type ListHosts struct {
+	Hosts []Host `json:"entries"`
+}
+
 // Host struct to capture host object
 type Host struct {
 	HostContent HostContent `json:"content"`
@@ -259,9 +386,10 @@ type StorageResourceParameters struct {
 
 // StorageResourceContent struct to capture Storage Resource content
 type StorageResourceContent struct {
-	ID         string          `json:"id"`
-	Name       string          `json:"name,omitempty"`
-	Filesystem StorageResource `json:"filesystem,omitempty"`
+	ID           string          `json:"id"`
+	Name         string          `json:"name,omitempty"`
+	Filesystem   StorageResource `json:"filesystem,omitempty"`
+	SnapSchedule StorageResource `json:"snapSchedule,omitempty"`
 }
 
 // IoLimitPolicy struct IO limit policy object
@@ -285,6 +413,8 @@ type FileContent struct {
 	ID                     string        `json:"id"`
 	Name                   string        `json:"name,omitempty"`
 	SizeTotal              uint64        `json:"sizeTotal,omitempty"`
+	SizeUsed               uint64        `json:"sizeUsed,omitempty"`
+	SizeAllocated          uint64        `json:"sizeAllocated,omitempty"`
 	Description            string        `json:"description,omitempty"`
 	Type                   int           `json:"type,omitempty"`
 	Format                 int           `json:"format,omitempty"`
@@ -308,6 +438,11 @@ type Share struct {
 	ParentSnap StorageResource `json:"snap,omitempty"`
 }
 
+// ListFilesystems struct to capture the response of filesystem list
+type ListFilesystems struct {
+	Filesystems []Filesystem `json:"entries"`
+}
+
 // NFSShare struct to capture NFS Share object
 type NFSShare struct {
 	NFSShareContent NFSShareContent `json:"content"`
@@ -318,6 +453,7 @@ type NFSShareContent struct {
 	ID                      string        `json:"id"`
 	Name                    string        `json:"name,omitempty"`
 	Filesystem              Pool          `json:"filesystem,omitempty"`
+	Path                    string        `json:"path,omitempty"`
 	ReadOnlyHosts           []HostContent `json:"readOnlyHosts,omitempty"`
 	ReadWriteHosts          []HostContent `json:"readWriteHosts,omitempty"`
 	ReadOnlyRootAccessHosts []HostContent `json:"readOnlyRootAccessHosts,omitempty"`
@@ -493,6 +629,29 @@ type MetricInstance struct {
 	Content MetricInfo `json:"content"`
 }
 
+// ListMetricInstances comes from response from /api/types/metric/instances with fields
+type ListMetricInstances struct {
+	Entries []MetricInstance `json:"entries"`
+}
+
+// MetricValue holds the values of a historical metric at a timestamp
+type MetricValue struct {
+	Path      string                 `json:"path"`
+	Timestamp time.Time              `json:"timestamp"`
+	Interval  int                    `json:"interval"`
+	Values    map[string]interface{} `json:"values"`
+}
+
+// MetricValueEntry is part of the response from /api/types/metricValue/instances
+type MetricValueEntry struct {
+	Content MetricValue `json:"content"`
+}
+
+// ListMetricValues comes from response from /api/types/metricValue/instances
+type ListMetricValues struct {
+	Entries []MetricValueEntry `json:"entries"`
+}
+
 // SystemCapacityMetricResult is part of response of a SystemCapacityMetricsQueryResult query
 type SystemCapacityMetricResult struct {
 	ID               string `json:"id"`
@@ -529,3 +688,216 @@ type MaxVolumSizeContent struct {
 type MaxVolumSizeInfo struct {
 	MaxVolumSizeContent MaxVolumSizeContent `json:"content"`
 }
+
+// ListAlerts struct to capture alert list
+type ListAlerts struct {
+	Alerts []Alert `json:"entries"`
+}
+
+// Alert struct to capture alert object
+type Alert struct {
+	AlertContent AlertContent `json:"content"`
+}
+
+// AlertContent struct to capture alert parameters
+type AlertContent struct {
+	ID             string      `json:"id"`
+	Timestamp      time.Time   `json:"timestamp"`
+	Severity       int         `json:"severity"`
+	Component      ResourceRef `json:"component,omitempty"`
+	MessageID      string      `json:"messageId,omitempty"`
+	Message        string      `json:"message,omitempty"`
+	Description    string      `json:"description,omitempty"`
+	Resolution     string      `json:"resolution,omitempty"`
+	IsAcknowledged bool        `json:"isAcknowledged"`
+	State          int         `json:"state"`
+}
+
+// ResourceRef struct to capture a reference to another Unity resource
+type ResourceRef struct {
+	ID       string `json:"id"`
+	Resource string `json:"resource,omitempty"`
+}
+
+// ListEvents struct to capture event list
+type ListEvents struct {
+	Events []Event `json:"entries"`
+}
+
+// Event struct to capture event object
+type Event struct {
+	EventContent EventContent `json:"content"`
+}
+
+// EventContent struct to capture event parameters
+type EventContent struct {
+	ID           string    `json:"id"`
+	Node         int       `json:"node"`
+	CreationTime time.Time `json:"creationTime"`
+	Severity     int       `json:"severity"`
+	MessageID    string    `json:"messageId,omitempty"`
+	Arguments    []string  `json:"arguments,omitempty"`
+	Message      string    `json:"message,omitempty"`
+	Username     string    `json:"username,omitempty"`
+	Category     int       `json:"category"`
+	Source       string    `json:"source,omitempty"`
+}
+
+// ListLicenses struct to capture the list of license instances
+type ListLicenses struct {
+	Licenses []License `json:"entries"`
+}
+
+// License struct to capture a license instance
+type License struct {
+	LicenseContent LicenseContent `json:"content"`
+}
+
+// LicenseContent struct to capture the license attributes
+type LicenseContent struct {
+	ID          string    `json:"id"`
+	Name        string    `json:"name"`
+	IsInstalled bool      `json:"isInstalled"`
+	IsValid     bool      `json:"isValid"`
+	IsPermanent bool      `json:"isPermanent"`
+	Version     string    `json:"version"`
+	Issued      time.Time `json:"issued"`
+	Expires     time.Time `json:"expires"`
+}
+
+// ListStoragePools struct to capture the list of storage pools
+type ListStoragePools struct {
+	StoragePools []StoragePool `json:"entries"`
+}
+
+// ListSnapSchedules struct to capture the list of snapshot schedules
+type ListSnapSchedules struct {
+	SnapSchedules []SnapSchedule `json:"entries"`
+}
+
+// SnapSchedule struct to capture a snapshot schedule
+type SnapSchedule struct {
+	SnapScheduleContent SnapScheduleContent `json:"content"`
+}
+
+// SnapScheduleContent struct to capture the snapshot schedule parameters
+type SnapScheduleContent struct {
+	ID        string             `json:"id"`
+	Name      string             `json:"name,omitempty"`
+	IsDefault bool               `json:"isDefault"`
+	Rules     []SnapScheduleRule `json:"rules,omitempty"`
+}
+
+// SnapScheduleRule struct to capture a rule of a snapshot schedule. It is also used to create rules.
+type SnapScheduleRule struct {
+	ID            string `json:"id,omitempty"`
+	Type          int    `json:"type"`
+	Minute        int    `json:"minute"`
+	Hours         []int  `json:"hours,omitempty"`
+	DaysOfWeek    []int  `json:"daysOfWeek,omitempty"`
+	DaysOfMonth   []int  `json:"daysOfMonth,omitempty"`
+	Interval      int    `json:"interval,omitempty"`
+	IsAutoDelete  bool   `json:"isAutoDelete"`
+	RetentionTime uint64 `json:"retentionTime,omitempty"`
+}
+
+// ListPoolUnits struct to capture the list of pool units
+type ListPoolUnits struct {
+	PoolUnits []PoolUnit `json:"entries"`
+}
+
+// PoolUnit struct to capture a pool unit, a RAID group or virtual disk providing capacity to a pool
+type PoolUnit struct {
+	PoolUnitContent PoolUnitContent `json:"content"`
+}
+
+// PoolUnitContent struct to capture the pool unit attributes
+type PoolUnitContent struct {
+	ID          string        `json:"id"`
+	Name        string        `json:"name"`
+	Description string        `json:"description"`
+	Type        int           `json:"type"`
+	Health      HealthContent `json:"health"`
+	SizeTotal   uint64        `json:"sizeTotal"`
+	TierType    int           `json:"tierType"`
+	WWN         string        `json:"wwn"`
+	Pool        Pool          `json:"pool,omitempty"`
+}
+
+// ListDiskGroups struct to capture the list of disk groups
+type ListDiskGroups struct {
+	DiskGroups []DiskGroup `json:"entries"`
+}
+
+// DiskGroup struct to capture a disk group, the disks of the same type, size and speed
+type DiskGroup struct {
+	DiskGroupContent DiskGroupContent `json:"content"`
+}
+
+// DiskGroupContent struct to capture the disk group attributes
+type DiskGroupContent struct {
+	ID                    string `json:"id"`
+	Name                  string `json:"name"`
+	EmcPartNumber         string `json:"emcPartNumber"`
+	TierType              int    `json:"tierType"`
+	DiskTechnology        int    `json:"diskTechnology"`
+	IsFASTCacheAllowable  bool   `json:"isFASTCacheAllowable"`
+	DiskSize              uint64 `json:"diskSize"`
+	AdvertisedSize        uint64 `json:"advertisedSize"`
+	RPM                   int    `json:"rpm"`
+	TotalDisks            int    `json:"totalDisks"`
+	UnconfiguredDisks     int    `json:"unconfiguredDisks"`
+	MinHotSpareCandidates int    `json:"minHotSpareCandidates"`
+}
+
+// ListLoginSessionInfo struct to capture the login session list
+type ListLoginSessionInfo struct {
+	Entries []LoginSessionInfo `json:"entries"`
+}
+
+// LoginSessionInfo struct to capture the login session object
+type LoginSessionInfo struct {
+	LoginSessionInfoContent LoginSessionInfoContent `json:"content"`
+}
+
+// LoginSessionInfoContent struct to capture the login session parameters
+type LoginSessionInfoContent struct {
+	ID                       string      `json:"id"`
+	Domain                   string      `json:"domain,omitempty"`
+	User                     SessionUser `json:"user"`
+	Roles                    []Role      `json:"roles,omitempty"`
+	IdleTimeout              int         `json:"idleTimeout,omitempty"`
+	IsPasswordChangeRequired bool        `json:"isPasswordChangeRequired"`
+}
+
+// SessionUser struct to capture the user of a login session
+type SessionUser struct {
+	ID   string `json:"id"`
+	Name string `json:"name,omitempty"`
+}
+
+// Role struct to capture a role of a login session
+type Role struct {
+	ID string `json:"id"`
+}
+
+// ListReplicationSessions struct to capture the response of replication session list
+type ListReplicationSessions struct {
+	ReplicationSessions []ReplicationSession `json:"entries"`
+}
+
+// ReplicationSession struct to capture replication session object
+type ReplicationSession struct {
+	ReplicationSessionContent ReplicationSessionContent `json:"content"`
+}
+
+// ReplicationSessionContent struct to capture replication session parameters.
+// SrcResourceID and DstResourceID are the IDs of the replicated storage resources.
+type ReplicationSessionContent struct {
+	ID                      string `json:"id"`
+	Name                    string `json:"name,omitempty"`
+	ReplicationResourceType int    `json:"replicationResourceType,omitempty"`
+	Status                  int    `json:"status,omitempty"`
+	SrcResourceID           string `json:"srcResourceId,omitempty"`
+	DstResourceID           string `json:"dstResourceId,omitempty"`
+}
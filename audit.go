@@ -0,0 +1,193 @@
+/*
+ Copyright © 2025 Dell Inc. or its subsidiaries. All Rights Reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package gounity
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	util "github.com/dell/gounity/gounityutil"
+)
+
+// FindingCategory is the kind of leak found by AuditOrphans
+type FindingCategory string
+
+// FindingCategory constants
+const (
+	// FindingUnexportedLUN is a LUN without host access. LUNs marked for deletion are left to CollectMarkedResources.
+	FindingUnexportedLUN FindingCategory = "unexportedLun"
+	// FindingExpiredSnapshot is a snapshot past its expiration time which the array did not delete
+	FindingExpiredSnapshot FindingCategory = "expiredSnapshot"
+	// FindingCloneSnapshot is a snapshot left behind by CreateCloneFromVolume
+	FindingCloneSnapshot FindingCategory = "cloneSnapshot"
+	// FindingHostWithoutInitiators is a host with no initiator and no IP port. Hosts with IP ports are NFS clients.
+	FindingHostWithoutInitiators FindingCategory = "hostWithoutInitiators"
+	// FindingOrphanInitiator is an initiator registered on the array but not attached to a host
+	FindingOrphanInitiator FindingCategory = "orphanInitiator"
+)
+
+// AuditOptions of AuditOrphans
+type AuditOptions struct {
+	// NamePrefixes limits the audit to resources whose name starts with one of the prefixes. Initiators are matched on
+	// their WWN or IQN. All resources are audited if it is empty.
+	NamePrefixes []string
+	// MinAge ignores snapshots created more recently than this, such as the snapshot of a clone in progress.
+	// Other resources do not report their creation time and are not held back by MinAge.
+	MinAge time.Duration
+}
+
+// Finding is a resource which looks orphaned or leaked
+type Finding struct {
+	Category FindingCategory
+	ID       string
+	Name     string
+	// Size is the allocated size of a LUN or the size of a snapshot, in bytes
+	Size uint64
+	// Age is the time since the snapshot was created, or zero if it is not known
+	Age time.Duration
+	// Detail explains the finding
+	Detail string
+}
+
+// AuditReport holds the findings of AuditOrphans, in category order
+type AuditReport struct {
+	Findings []Finding
+}
+
+// ByCategory returns the findings of a category
+func (r *AuditReport) ByCategory(category FindingCategory) []Finding {
+	var findings []Finding
+	for _, finding := range r.Findings {
+		if finding.Category == category {
+			findings = append(findings, finding)
+		}
+	}
+	return findings
+}
+
+// TotalSize returns the size of the findings of a category, or of all findings if category is empty
+func (r *AuditReport) TotalSize(category FindingCategory) uint64 {
+	var size uint64
+	for _, finding := range r.Findings {
+		if category == "" || finding.Category == category {
+			size += finding.Size
+		}
+	}
+	return size
+}
+
+// auditScope tells whether a resource is audited
+type auditScope []string
+
+func (s auditScope) includes(name string) bool {
+	if len(s) == 0 {
+		return true
+	}
+	for _, prefix := range s {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// AuditOrphans scans the LUNs, snapshots, hosts and initiators of the array for resources which look orphaned or
+// leaked. It only reports them; nothing is deleted.
+func (c *UnityClientImpl) AuditOrphans(ctx context.Context, opts AuditOptions) (*AuditReport, error) {
+	log := util.GetRunIDLogger(ctx)
+	scope := auditScope(opts.NamePrefixes)
+	now := time.Now()
+	report := &AuditReport{}
+
+	volumes, _, err := c.ListVolumes(ctx, 0, 0)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list volumes: %v", err)
+	}
+	for _, volume := range volumes {
+		content := volume.VolumeContent
+		if len(content.HostAccessResponse) > 0 || strings.Contains(content.Name, MarkVolumeForDeletion) || !scope.includes(content.Name) {
+			continue
+		}
+		report.Findings = append(report.Findings, Finding{
+			Category: FindingUnexportedLUN, ID: content.ResourceID, Name: content.Name, Size: content.SizeAllocated,
+			Detail: "no host has access to the LUN",
+		})
+	}
+
+	snapshots, _, err := c.ListSnapshots(ctx, 0, 0, "", "")
+	if err != nil {
+		return nil, fmt.Errorf("unable to list snapshots: %v", err)
+	}
+	var expired, clones []Finding
+	for _, snapshot := range snapshots {
+		content := snapshot.SnapshotContent
+		if !scope.includes(content.Name) {
+			continue
+		}
+		finding := Finding{ID: content.ResourceID, Name: content.Name, Size: uint64(content.Size)}
+		if !content.CreationTime.IsZero() {
+			finding.Age = now.Sub(content.CreationTime)
+			if finding.Age < opts.MinAge {
+				continue
+			}
+		}
+		switch {
+		case strings.HasPrefix(content.Name, SnapForClone):
+			finding.Category = FindingCloneSnapshot
+			finding.Detail = fmt.Sprintf("clone snapshot of %s was not deleted", content.StorageResource.ID)
+			clones = append(clones, finding)
+		case !content.ExpirationTime.IsZero() && content.ExpirationTime.Before(now):
+			finding.Category = FindingExpiredSnapshot
+			finding.Detail = fmt.Sprintf("expired %v ago", now.Sub(content.ExpirationTime).Truncate(time.Second))
+			expired = append(expired, finding)
+		}
+	}
+	report.Findings = append(append(report.Findings, expired...), clones...)
+
+	hosts, err := c.ListHosts(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, host := range hosts {
+		content := host.HostContent
+		if len(content.FcInitiators)+len(content.IscsiInitiators)+len(content.IPPorts) > 0 || !scope.includes(content.Name) {
+			continue
+		}
+		report.Findings = append(report.Findings, Finding{
+			Category: FindingHostWithoutInitiators, ID: content.ID, Name: content.Name,
+			Detail: "host has no initiator and no IP port",
+		})
+	}
+
+	initiators, err := c.ListHostInitiators(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list host initiators: %v", err)
+	}
+	for _, initiator := range initiators {
+		content := initiator.HostInitiatorContent
+		if content.ParentHost.ID != "" || !scope.includes(content.InitiatorID) {
+			continue
+		}
+		report.Findings = append(report.Findings, Finding{
+			Category: FindingOrphanInitiator, ID: content.ID, Name: content.InitiatorID,
+			Detail: "initiator is not attached to a host",
+		})
+	}
+
+	log.Infof("Audit found %d orphaned resources holding %d bytes", len(report.Findings), report.TotalSize(""))
+	return report, nil
+}
@@ -0,0 +1,92 @@
+/*
+ Copyright © 2025 Dell Inc. or its subsidiaries. All Rights Reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package gounity
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	types "github.com/dell/gounity/apitypes"
+	mocksapi "github.com/dell/gounity/mocks/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestAuditOrphans(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	apiClient := &mocksapi.Client{}
+	client := &UnityClientImpl{api: apiClient, configConnect: &ConfigConnect{}}
+
+	apiClient.On("DoWithHeaders", mock.Anything, "GET", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		switch resp := args.Get(5).(type) {
+		case *types.ListVolumes:
+			resp.Volumes = []types.Volume{
+				{VolumeContent: types.VolumeContent{ResourceID: "sv_1", Name: "csi-vol1", SizeAllocated: 1 << 30}},
+				{VolumeContent: types.VolumeContent{ResourceID: "sv_2", Name: "csi-vol2", HostAccessResponse: []types.HostAccessResponse{{}}}},
+				{VolumeContent: types.VolumeContent{ResourceID: "sv_3", Name: MarkVolumeForDeletion + "1700000000"}},
+				{VolumeContent: types.VolumeContent{ResourceID: "sv_4", Name: "other", SizeAllocated: 1 << 20}},
+			}
+		case *types.ListSnapshot:
+			resp.Snapshots = []types.Snapshot{
+				{SnapshotContent: types.SnapshotContent{ResourceID: "1", Name: "csi-snap1", Size: 100, CreationTime: now.Add(-48 * time.Hour), ExpirationTime: now.Add(-time.Hour)}},
+				{SnapshotContent: types.SnapshotContent{ResourceID: "2", Name: "csi-snap2", CreationTime: now.Add(-48 * time.Hour), ExpirationTime: now.Add(time.Hour)}},
+				{SnapshotContent: types.SnapshotContent{ResourceID: "3", Name: SnapForClone + "1700000000", Size: 200, CreationTime: now.Add(-2 * time.Hour), StorageResource: types.StorageResource{ID: "sv_1"}}},
+				{SnapshotContent: types.SnapshotContent{ResourceID: "4", Name: SnapForClone + "1800000000", CreationTime: now}},
+			}
+		case *types.ListHosts:
+			resp.Hosts = []types.Host{
+				{HostContent: types.HostContent{ID: "Host_1", Name: "csi-node1"}},
+				{HostContent: types.HostContent{ID: "Host_2", Name: "csi-node2", FcInitiators: []types.Initiators{{ID: "HostInitiator_1"}}}},
+				{HostContent: types.HostContent{ID: "Host_3", Name: "csi-nfs", IPPorts: []types.IPPorts{{ID: "HostNetworkAddress_1"}}}},
+			}
+		case *types.ListHostInitiator:
+			resp.HostInitiator = []types.HostInitiator{
+				{HostInitiatorContent: types.HostInitiatorContent{ID: "HostInitiator_1", InitiatorID: "csi-iqn", ParentHost: types.HostContent{ID: "Host_2"}}},
+				{HostInitiatorContent: types.HostInitiatorContent{ID: "HostInitiator_2", InitiatorID: "csi-iqn2"}},
+			}
+		}
+	})
+
+	report, err := client.AuditOrphans(ctx, AuditOptions{NamePrefixes: []string{"csi-"}, MinAge: time.Hour})
+	assert.NoError(t, err)
+	var ids []string
+	for _, finding := range report.Findings {
+		ids = append(ids, string(finding.Category)+"/"+finding.ID)
+	}
+	assert.Equal(t, []string{
+		"unexportedLun/sv_1", "expiredSnapshot/1", "cloneSnapshot/3", "hostWithoutInitiators/Host_1", "orphanInitiator/HostInitiator_2",
+	}, ids)
+	assert.Equal(t, uint64(1<<30+300), report.TotalSize(""))
+	assert.Equal(t, uint64(200), report.TotalSize(FindingCloneSnapshot))
+	assert.Equal(t, 2*time.Hour, report.ByCategory(FindingCloneSnapshot)[0].Age.Round(time.Hour))
+	apiClient.AssertCalled(t, "DoWithHeaders", mock.Anything, "GET", "/api/types/host/instances?fields="+HostfieldsToQuery, mock.Anything, mock.Anything, mock.Anything)
+
+	report, err = client.AuditOrphans(ctx, AuditOptions{})
+	assert.NoError(t, err)
+	assert.Len(t, report.ByCategory(FindingUnexportedLUN), 2)
+	assert.Len(t, report.ByCategory(FindingCloneSnapshot), 2)
+
+	// Negative cases
+	apiClient = &mocksapi.Client{}
+	apiClient.On("DoWithHeaders", anyArgs...).Return(errors.New("error"))
+	client = &UnityClientImpl{api: apiClient, configConnect: &ConfigConnect{}}
+	_, err = client.AuditOrphans(ctx, AuditOptions{})
+	assert.ErrorContains(t, err, "unable to list volumes")
+	_, err = client.ListHosts(ctx)
+	assert.ErrorContains(t, err, "list hosts failed")
+}
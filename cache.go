@@ -0,0 +1,198 @@
+/*
+ Copyright © 2025 Dell Inc. or its subsidiaries. All Rights Reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package gounity
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	util "github.com/dell/gounity/gounityutil"
+	"golang.org/x/sync/singleflight"
+)
+
+// cacheKind is a kind of resource cached by the resource cache
+type cacheKind string
+
+// Kinds of resources cached by the resource cache
+const (
+	cachePools           cacheKind = "pool"
+	cacheTenants         cacheKind = "tenant"
+	cacheIPInterfaces    cacheKind = "ipInterface"
+	cacheInitiators      cacheKind = "hostInitiator"
+	cacheNASServers      cacheKind = "nasServer"
+	cacheIOLimitPolicies cacheKind = "ioLimitPolicy"
+)
+
+type noCacheKey struct{}
+
+// WithNoCache returns a context for which the client reads resources from the array instead of its caches.
+// The responses still refresh the caches.
+func WithNoCache(ctx context.Context) context.Context {
+	return context.WithValue(ctx, noCacheKey{}, true)
+}
+
+// isNoCache returns true if the context was created by WithNoCache
+func isNoCache(ctx context.Context) bool {
+	noCache, _ := ctx.Value(noCacheKey{}).(bool)
+	return noCache
+}
+
+// resourceCacheEntry holds a cached response body
+type resourceCacheEntry struct {
+	data    []byte
+	expires time.Time
+}
+
+// resourceCache caches the GET responses of rarely changing resources, keyed by kind and URI.
+// Mutations invalidate every entry of the kind they change. Since pools report their free and used capacity,
+// every creation, expansion and deletion of a LUN, filesystem or snapshot invalidates the pools.
+type resourceCache struct {
+	mutex   sync.Mutex
+	ttl     time.Duration
+	entries map[cacheKind]map[string]resourceCacheEntry
+	// generations counts the invalidations of each kind, so that a response read before an invalidation is not stored
+	generations map[cacheKind]uint64
+	group       singleflight.Group
+}
+
+func (r *resourceCache) enabled() bool {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return r.ttl > 0
+}
+
+func (r *resourceCache) get(kind cacheKind, uri string) ([]byte, bool) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	entry, ok := r.entries[kind][uri]
+	if !ok || time.Now().After(entry.expires) {
+		return nil, false
+	}
+	return entry.data, true
+}
+
+func (r *resourceCache) generation(kind cacheKind) uint64 {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return r.generations[kind]
+}
+
+// put stores the response unless the kind was invalidated since the given generation
+func (r *resourceCache) put(kind cacheKind, uri string, data []byte, generation uint64) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if r.ttl <= 0 || r.generations[kind] != generation {
+		return
+	}
+	if r.entries == nil {
+		r.entries = make(map[cacheKind]map[string]resourceCacheEntry)
+	}
+	if r.entries[kind] == nil {
+		r.entries[kind] = make(map[string]resourceCacheEntry)
+	}
+	r.entries[kind][uri] = resourceCacheEntry{
+		data:    data,
+		expires: time.Now().Add(r.ttl),
+	}
+}
+
+func (r *resourceCache) invalidate(kinds ...cacheKind) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if r.generations == nil {
+		r.generations = make(map[cacheKind]uint64)
+	}
+	for _, kind := range kinds {
+		delete(r.entries, kind)
+		r.generations[kind]++
+	}
+}
+
+func (r *resourceCache) setTTL(ttl time.Duration) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.ttl = ttl
+	r.entries = nil
+}
+
+// SetResourceCacheTTL sets the time for which pools, tenants, IP interfaces, host initiators, NAS servers and
+// I/O limit policies are cached. A TTL of zero or less disables caching. Changing the TTL clears the cache.
+func (c *UnityClientImpl) SetResourceCacheTTL(ttl time.Duration) {
+	c.resourceCache.setTTL(ttl)
+}
+
+// getCached makes a GET request through the resource cache. Concurrent requests of the same URI missing the cache
+// share a single call to the array.
+func (c *UnityClientImpl) getCached(ctx context.Context, kind cacheKind, uri string, resp interface{}) error {
+	if !c.resourceCache.enabled() {
+		return c.executeWithRetryAuthenticate(ctx, http.MethodGet, uri, nil, resp)
+	}
+	log := util.GetRunIDLogger(ctx)
+
+	fetch := func(ctx context.Context) ([]byte, error) {
+		generation := c.resourceCache.generation(kind)
+		data := json.RawMessage{}
+		if err := c.executeWithRetryAuthenticate(ctx, http.MethodGet, uri, nil, &data); err != nil {
+			return nil, err
+		}
+		c.resourceCache.put(kind, uri, data, generation)
+		return data, nil
+	}
+
+	var (
+		data []byte
+		err  error
+	)
+	if isNoCache(ctx) {
+		data, err = fetch(ctx)
+	} else if cached, ok := c.resourceCache.get(kind, uri); ok {
+		log.Debugf("Returning cached response of %s", uri)
+		data = cached
+	} else {
+		// The shared fetch is not cancelled with the caller starting it, which would fail every caller sharing it.
+		// Each caller stops waiting when its own context is done.
+		results := c.resourceCache.group.DoChan(uri, func() (interface{}, error) {
+			fetchCtx := context.WithoutCancel(ctx)
+			if deadline, ok := ctx.Deadline(); ok {
+				var cancel context.CancelFunc
+				fetchCtx, cancel = context.WithDeadline(fetchCtx, deadline)
+				defer cancel()
+			}
+			return fetch(fetchCtx)
+		})
+		select {
+		case res := <-results:
+			if res.Shared {
+				log.Debugf("Shared the response of %s with concurrent requests", uri)
+			}
+			err = res.Err
+			if err == nil {
+				data = res.Val.([]byte)
+			}
+		case <-ctx.Done():
+			err = ctx.Err()
+		}
+	}
+	if err != nil {
+		return err
+	}
+	if len(data) == 0 || resp == nil {
+		return nil
+	}
+	return json.Unmarshal(data, resp)
+}
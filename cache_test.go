@@ -0,0 +1,182 @@
+/*
+ Copyright © 2025 Dell Inc. or its subsidiaries. All Rights Reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package gounity
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	types "github.com/dell/gounity/apitypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourceCache(t *testing.T) {
+	ctx := context.Background()
+	var poolRequests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet && strings.HasPrefix(r.URL.Path, "/api/instances/pool/") {
+			poolRequests.Add(1)
+			_, _ = w.Write([]byte(`{"content": {"id": "pool_1", "name": "pool", "sizeFree": 100}}`))
+			return
+		}
+		_, _ = w.Write([]byte("{}"))
+	}))
+	defer server.Close()
+
+	client, err := NewClientWithOptions(ctx, server.URL, WithResourceCacheTTL(time.Minute))
+	require.NoError(t, err)
+
+	pool, err := client.FindStoragePoolByID(ctx, "pool_1")
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(100), pool.StoragePoolContent.FreeCapacity)
+	pool, err = client.FindStoragePoolByID(ctx, "pool_1")
+	assert.NoError(t, err)
+	assert.Equal(t, "pool", pool.StoragePoolContent.Name)
+	assert.Equal(t, int32(1), poolRequests.Load())
+
+	// Callers get their own copy of the cached pool
+	pool.StoragePoolContent.Name = "changed"
+	pool, _ = client.FindStoragePoolByID(ctx, "pool_1")
+	assert.Equal(t, "pool", pool.StoragePoolContent.Name)
+
+	// WithNoCache reads from the array
+	_, err = client.FindStoragePoolByID(WithNoCache(ctx), "pool_1")
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), poolRequests.Load())
+
+	// Modifying a pool invalidates the cached pools
+	assert.NoError(t, client.ModifyStoragePool(ctx, "pool_1", types.PoolModifyParam{Description: "modified"}))
+	_, err = client.FindStoragePoolByID(ctx, "pool_1")
+	assert.NoError(t, err)
+	assert.Equal(t, int32(3), poolRequests.Load())
+
+	// Disabling the cache clears it
+	client.SetResourceCacheTTL(0)
+	_, err = client.FindStoragePoolByID(ctx, "pool_1")
+	assert.NoError(t, err)
+	assert.Equal(t, int32(4), poolRequests.Load())
+}
+
+func TestResourceCacheSingleflight(t *testing.T) {
+	ctx := context.Background()
+	var requests atomic.Int32
+	unblock := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requests.Add(1)
+		<-unblock
+		_, _ = w.Write([]byte(`{"entries": [{"content": {"id": "tenant_1", "name": "tenant"}}]}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClientWithOptions(ctx, server.URL, WithResourceCacheTTL(time.Minute))
+	require.NoError(t, err)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			tenants, err := client.FindTenants(ctx)
+			assert.NoError(t, err)
+			assert.Len(t, tenants.Entries, 1)
+		}()
+	}
+	assert.Eventually(t, func() bool { return requests.Load() == 1 }, time.Second, 5*time.Millisecond)
+	time.Sleep(100 * time.Millisecond)
+	close(unblock)
+	wg.Wait()
+	assert.Equal(t, int32(1), requests.Load())
+}
+
+func TestResourceCacheSingleflightCancellation(t *testing.T) {
+	ctx := context.Background()
+	var requests atomic.Int32
+	unblock := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requests.Add(1)
+		<-unblock
+		_, _ = w.Write([]byte(`{"entries": [{"content": {"id": "tenant_1", "name": "tenant"}}]}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClientWithOptions(ctx, server.URL, WithResourceCacheTTL(time.Minute))
+	require.NoError(t, err)
+
+	// the caller starting the fetch gives up, the caller sharing it still gets the response
+	cancelCtx, cancel := context.WithCancel(ctx)
+	cancelled := make(chan error)
+	go func() {
+		_, err := client.FindTenants(cancelCtx)
+		cancelled <- err
+	}()
+	assert.Eventually(t, func() bool { return requests.Load() == 1 }, time.Second, 5*time.Millisecond)
+
+	shared := make(chan error)
+	go func() {
+		tenants, err := client.FindTenants(ctx)
+		if err == nil {
+			assert.Len(t, tenants.Entries, 1)
+		}
+		shared <- err
+	}()
+	time.Sleep(100 * time.Millisecond)
+	cancel()
+	assert.ErrorContains(t, <-cancelled, context.Canceled.Error())
+	close(unblock)
+	assert.NoError(t, <-shared)
+	assert.Equal(t, int32(1), requests.Load())
+}
+
+func TestResourceCacheInvalidation(t *testing.T) {
+	cache := &resourceCache{}
+	cache.setTTL(time.Minute)
+
+	// A response read before an invalidation is not stored
+	generation := cache.generation(cacheInitiators)
+	cache.invalidate(cacheInitiators)
+	cache.put(cacheInitiators, "/api/types/hostInitiator/instances", []byte("{}"), generation)
+	_, ok := cache.get(cacheInitiators, "/api/types/hostInitiator/instances")
+	assert.False(t, ok)
+
+	cache.put(cacheInitiators, "/api/types/hostInitiator/instances", []byte("{}"), cache.generation(cacheInitiators))
+	cache.put(cacheTenants, "/api/types/tenant/instances", []byte("{}"), cache.generation(cacheTenants))
+	cache.invalidate(cacheInitiators)
+	_, ok = cache.get(cacheInitiators, "/api/types/hostInitiator/instances")
+	assert.False(t, ok)
+	_, ok = cache.get(cacheTenants, "/api/types/tenant/instances")
+	assert.True(t, ok)
+
+	// Capacity changes invalidate the pools
+	client := &UnityClientImpl{}
+	client.resourceCache.setTTL(time.Minute)
+	client.resourceCache.put(cachePools, "/api/types/pool/instances", []byte("{}"), client.resourceCache.generation(cachePools))
+	assert.Error(t, client.DeleteVolume(context.Background(), ""))
+	_, ok = client.resourceCache.get(cachePools, "/api/types/pool/instances")
+	assert.False(t, ok)
+
+	// Negative case: nothing is stored while the cache is disabled
+	cache.setTTL(0)
+	cache.put(cacheTenants, "/api/types/tenant/instances", []byte("{}"), cache.generation(cacheTenants))
+	_, ok = cache.get(cacheTenants, "/api/types/tenant/instances")
+	assert.False(t, ok)
+	assert.False(t, isNoCache(context.Background()))
+}
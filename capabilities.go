@@ -0,0 +1,192 @@
+/*
+ Copyright © 2025 Dell Inc. or its subsidiaries. All Rights Reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package gounity
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	types "github.com/dell/gounity/apitypes"
+)
+
+// Feature is a Unity OE feature which is not available on every array
+type Feature string
+
+// Feature constants
+const (
+	FeatureIOLimitPolicy Feature = "IOLimitPolicy"
+	FeatureMultiTenancy  Feature = "MultiTenancy"
+	FeatureThinClone     Feature = "ThinClone"
+	FeatureDynamicPools  Feature = "DynamicPools"
+	FeatureDataReduction Feature = "DataReduction"
+	FeatureAdvancedDedup Feature = "AdvancedDedup"
+)
+
+// ErrUnsupportedByArray is returned when an operation needs a feature the array's Unity OE does not support
+var ErrUnsupportedByArray = errors.New("operation is not supported by the array")
+
+// featureMinVersions holds the earliest Unity OE software version supporting each feature
+var featureMinVersions = map[Feature]string{
+	FeatureIOLimitPolicy: "4.1",
+	FeatureMultiTenancy:  "4.1",
+	FeatureThinClone:     "4.2",
+	FeatureDynamicPools:  "4.2",
+	FeatureDataReduction: "4.3",
+	FeatureAdvancedDedup: "4.5",
+}
+
+// fieldFeatures maps the fields of each resource type to the feature introducing them
+var fieldFeatures = map[string]map[string]Feature{
+	"lun": {
+		"ioLimitPolicy":          FeatureIOLimitPolicy,
+		"isThinClone":            FeatureThinClone,
+		"parentSnap":             FeatureThinClone,
+		"originalParentLun":      FeatureThinClone,
+		"isDataReductionEnabled": FeatureDataReduction,
+		"isAdvancedDedupEnabled": FeatureAdvancedDedup,
+	},
+	"filesystem": {
+		"isDataReductionEnabled": FeatureDataReduction,
+		"isAdvancedDedupEnabled": FeatureAdvancedDedup,
+	},
+	"pool": {
+		"hasDataReductionEnabledLuns": FeatureDataReduction,
+		"hasDataReductionEnabledFs":   FeatureDataReduction,
+	},
+	"host": {
+		"tenant": FeatureMultiTenancy,
+	},
+	"nasServer": {
+		"tenant": FeatureMultiTenancy,
+	},
+}
+
+// Capabilities holds the versions reported by basicSystemInfo and the features supported by the array
+type Capabilities struct {
+	SoftwareVersion    string
+	APIVersion         string
+	EarliestAPIVersion string
+	features           map[Feature]bool
+}
+
+// NewCapabilities builds the capability set of an array from its basicSystemInfo content
+func NewCapabilities(info *types.Content) *Capabilities {
+	capabilities := &Capabilities{
+		SoftwareVersion:    info.SoftwareVersion,
+		APIVersion:         info.APIVersion,
+		EarliestAPIVersion: info.EarliestAPIVersion,
+		features:           make(map[Feature]bool, len(featureMinVersions)),
+	}
+	if info.SoftwareVersion == "" {
+		return capabilities
+	}
+	for feature, minVersion := range featureMinVersions {
+		capabilities.features[feature] = compareVersions(info.SoftwareVersion, minVersion) >= 0
+	}
+	return capabilities
+}
+
+// Supports returns true if the array supports the given feature.
+// Features unknown to gounity and a nil capability set are assumed to be supported.
+func (c *Capabilities) Supports(feature Feature) bool {
+	if c == nil {
+		return true
+	}
+	supported, ok := c.features[feature]
+	return !ok || supported
+}
+
+// Require returns ErrUnsupportedByArray if the array does not support the given feature
+func (c *Capabilities) Require(feature Feature) error {
+	if c.Supports(feature) {
+		return nil
+	}
+	return fmt.Errorf("%w: %s requires Unity OE %s or later, array runs %s", ErrUnsupportedByArray, feature, featureMinVersions[feature], c.SoftwareVersion)
+}
+
+// FilterFields removes the fields of the given resource type which the array does not support from a comma separated field list
+func (c *Capabilities) FilterFields(resource, fields string) string {
+	resourceFields, ok := fieldFeatures[resource]
+	if c == nil || !ok {
+		return fields
+	}
+	var supported []string
+	for _, field := range strings.Split(fields, ",") {
+		// Nested fields such as nfsShare?fields or pool.name are keyed by the attribute name
+		name := strings.FieldsFunc(field, func(r rune) bool { return r == '?' || r == '.' })
+		if len(name) > 0 {
+			if feature, ok := resourceFields[name[0]]; ok && !c.Supports(feature) {
+				continue
+			}
+		}
+		supported = append(supported, field)
+	}
+	return strings.Join(supported, ",")
+}
+
+// filterURIFields removes the unsupported fields from the fields query parameter of a Unity REST URI
+func (c *Capabilities) filterURIFields(uri string) string {
+	if c == nil {
+		return uri
+	}
+	start := strings.Index(uri, "fields=")
+	if start < 0 {
+		return uri
+	}
+	start += len("fields=")
+	end := strings.Index(uri[start:], "&")
+	if end < 0 {
+		end = len(uri)
+	} else {
+		end += start
+	}
+	filtered := c.FilterFields(uriResourceType(uri), uri[start:end])
+	return uri[:start] + filtered + uri[end:]
+}
+
+// uriResourceType returns the resource type of a Unity REST URI such as /api/types/lun/instances or /api/instances/lun/sv_1
+func uriResourceType(uri string) string {
+	path := uri
+	if i := strings.Index(path, "?"); i >= 0 {
+		path = path[:i]
+	}
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	for i := 0; i+1 < len(segments); i++ {
+		if segments[i] == "types" || segments[i] == "instances" {
+			return segments[i+1]
+		}
+	}
+	return ""
+}
+
+// compareVersions compares two dotted version strings such as 5.3.0.0.5.120 and 4.5.
+// Only the components present in both versions are compared.
+func compareVersions(version, other string) int {
+	v1 := strings.Split(version, ".")
+	v2 := strings.Split(other, ".")
+	for i := 0; i < len(v1) && i < len(v2); i++ {
+		n1, _ := strconv.Atoi(v1[i])
+		n2, _ := strconv.Atoi(v2[i])
+		if n1 != n2 {
+			if n1 < n2 {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
@@ -0,0 +1,95 @@
+/*
+ Copyright © 2025 Dell Inc. or its subsidiaries. All Rights Reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package gounity
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	types "github.com/dell/gounity/apitypes"
+	mocksapi "github.com/dell/gounity/mocks/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCapabilitiesSupports(t *testing.T) {
+	capabilities := NewCapabilities(&types.Content{SoftwareVersion: "4.2.1.9535982", APIVersion: "7.0", EarliestAPIVersion: "4.0"})
+	assert.True(t, capabilities.Supports(FeatureThinClone))
+	assert.False(t, capabilities.Supports(FeatureDataReduction))
+	assert.False(t, capabilities.Supports(FeatureAdvancedDedup))
+	assert.True(t, capabilities.Supports(Feature("Unknown")))
+
+	err := capabilities.Require(FeatureAdvancedDedup)
+	assert.True(t, errors.Is(err, ErrUnsupportedByArray))
+	assert.NoError(t, capabilities.Require(FeatureThinClone))
+
+	capabilities = NewCapabilities(&types.Content{SoftwareVersion: "5.3.0.0.5.120"})
+	assert.True(t, capabilities.Supports(FeatureAdvancedDedup))
+
+	// Unknown versions support every feature
+	var unknown *Capabilities
+	assert.True(t, unknown.Supports(FeatureAdvancedDedup))
+	assert.True(t, NewCapabilities(&types.Content{}).Supports(FeatureAdvancedDedup))
+}
+
+func TestCapabilitiesFilterFields(t *testing.T) {
+	capabilities := NewCapabilities(&types.Content{SoftwareVersion: "4.1.0"})
+	fields := capabilities.FilterFields("lun", LunDisplayFields)
+	assert.Equal(t, "id,name,description,type,wwn,sizeTotal,sizeUsed,sizeAllocated,hostAccess,pool,tieringPolicy,ioLimitPolicy,isThinEnabled,health", fields)
+	assert.Equal(t, FileSystemDisplayFields, capabilities.FilterFields("nfsShare", FileSystemDisplayFields))
+
+	uri := fmt.Sprintf("/api/instances/lun/sv_1?fields=%s&compact=true", LunDisplayFields)
+	assert.Equal(t, "/api/instances/lun/sv_1?fields="+fields+"&compact=true", capabilities.filterURIFields(uri))
+	assert.Equal(t, "/api/types/pool/instances?fields=id,name", capabilities.filterURIFields("/api/types/pool/instances?fields=id,name,hasDataReductionEnabledLuns"))
+}
+
+func TestDetectCapabilities(t *testing.T) {
+	client := &UnityClientImpl{api: &mocksapi.Client{}, configConnect: &ConfigConnect{}}
+	assert.Nil(t, client.GetCapabilities())
+	assert.True(t, client.Supports(FeatureDataReduction))
+
+	client.detectCapabilities(context.Background(), strings.NewReader(`{"entries":[{"content":{"id":"0","softwareVersion":"4.2.0","apiVersion":"7.0","earliestApiVersion":"4.0"}}]}`))
+	assert.Equal(t, "7.0", client.GetCapabilities().APIVersion)
+	assert.False(t, client.Supports(FeatureDataReduction))
+
+	// Invalid responses keep the capabilities detected before
+	client.detectCapabilities(context.Background(), strings.NewReader("invalid"))
+	assert.Equal(t, "4.2.0", client.GetCapabilities().SoftwareVersion)
+}
+
+func TestUnsupportedByArray(t *testing.T) {
+	apiClient := &mocksapi.Client{}
+	client := &UnityClientImpl{api: apiClient, configConnect: &ConfigConnect{}}
+	client.setCapabilities(context.Background(), &types.Content{SoftwareVersion: "4.1.0"})
+	ctx := context.Background()
+
+	_, err := client.CreateLun(ctx, "lun", "pool_1", "", 1024, 0, "", true, true)
+	assert.True(t, errors.Is(err, ErrUnsupportedByArray))
+
+	_, err = client.CreateFilesystem(ctx, "fs", "pool_1", "", "nas_1", 1024, 0, 8192, 0, true, true)
+	assert.True(t, errors.Is(err, ErrUnsupportedByArray))
+
+	_, err = client.CreateCloneFromVolume(ctx, "clone", "sv_1")
+	assert.True(t, errors.Is(err, ErrUnsupportedByArray))
+
+	// Unsupported fields are dropped from the request
+	apiClient.On("DoWithHeaders", mock.Anything, "GET", "/api/instances/lun/sv_1?fields=id,name,ioLimitPolicy,health", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+	err = client.executeWithRetryAuthenticate(ctx, "GET", "/api/instances/lun/sv_1?fields=id,name,ioLimitPolicy,isThinClone,health", nil, nil)
+	assert.NoError(t, err)
+	apiClient.AssertExpectations(t)
+}
@@ -0,0 +1,93 @@
+/*
+ Copyright © 2025 Dell Inc. or its subsidiaries. All Rights Reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package gounity
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+
+	"github.com/dell/gounity/api"
+	util "github.com/dell/gounity/gounityutil"
+)
+
+// ArrayCertificate holds the certificate chain presented by an array
+type ArrayCertificate struct {
+	// Chain is the certificate chain, leaf first
+	Chain []*x509.Certificate
+	// SPKIFingerprint is the fingerprint of the leaf public key, usable in ClientOptions.PinnedSPKIFingerprints
+	SPKIFingerprint string
+	// PEM is the PEM encoded chain, usable in ClientOptions.CACertificates
+	PEM []byte
+}
+
+// FetchArrayCertificate connects to the endpoint without verifying its certificate and returns the certificate
+// chain it presents. It is meant for trust-on-first-use bootstrapping: the caller confirms the fingerprint out of
+// band, then persists the fingerprint or the PEM and uses it in ClientOptions for every later connection.
+func FetchArrayCertificate(ctx context.Context, endpoint string) (*ArrayCertificate, error) {
+	log := util.GetRunIDLogger(ctx)
+	address, err := endpointAddress(endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	dialer := &tls.Dialer{Config: &tls.Config{InsecureSkipVerify: true}} // #nosec G402
+	conn, err := dialer.DialContext(ctx, "tcp", address)
+	if err != nil {
+		return nil, fmt.Errorf("unable to connect to %s: %v", address, err)
+	}
+	defer conn.Close()
+
+	chain := conn.(*tls.Conn).ConnectionState().PeerCertificates
+	if len(chain) == 0 {
+		return nil, fmt.Errorf("no certificate presented by %s", address)
+	}
+	certificate := &ArrayCertificate{Chain: chain, SPKIFingerprint: api.SPKIFingerprint(chain[0])}
+	buf := &bytes.Buffer{}
+	for _, cert := range chain {
+		if err := pem.Encode(buf, &pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw}); err != nil {
+			return nil, fmt.Errorf("unable to encode certificate of %s: %v", address, err)
+		}
+	}
+	certificate.PEM = buf.Bytes()
+	log.Infof("Array %s presented certificate %q with SPKI fingerprint %s", address, chain[0].Subject.CommonName, certificate.SPKIFingerprint)
+	return certificate, nil
+}
+
+// endpointAddress returns the host:port of an endpoint such as https://10.0.0.1 or 10.0.0.1:8443
+func endpointAddress(endpoint string) (string, error) {
+	if endpoint == "" {
+		return "", errors.New("endpoint is required")
+	}
+	if !strings.Contains(endpoint, "://") {
+		endpoint = "https://" + endpoint
+	}
+	u, err := url.Parse(endpoint)
+	if err != nil || u.Hostname() == "" {
+		return "", fmt.Errorf("invalid endpoint %s", endpoint)
+	}
+	port := u.Port()
+	if port == "" {
+		port = "443"
+	}
+	return net.JoinHostPort(u.Hostname(), port), nil
+}
@@ -0,0 +1,68 @@
+/*
+ Copyright © 2025 Dell Inc. or its subsidiaries. All Rights Reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package gounity
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/dell/gounity/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFetchArrayCertificate(t *testing.T) {
+	ctx := context.Background()
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	certificate, err := FetchArrayCertificate(ctx, server.URL)
+	require.NoError(t, err)
+	assert.Equal(t, server.Certificate().Raw, certificate.Chain[0].Raw)
+	assert.Equal(t, api.SPKIFingerprint(server.Certificate()), certificate.SPKIFingerprint)
+
+	// The fetched certificate is trusted by later clients
+	_, err = NewClientWithClientOptions(ctx, server.URL, api.ClientOptions{CACertificates: certificate.PEM})
+	assert.NoError(t, err)
+
+	// Negative cases
+	_, err = FetchArrayCertificate(ctx, "")
+	assert.Error(t, err)
+	_, err = FetchArrayCertificate(ctx, "https://127.0.0.1:1")
+	assert.Error(t, err)
+	_, err = NewClientWithClientOptions(ctx, server.URL, api.ClientOptions{CACertificatesFile: "/nonexistent/ca.pem"})
+	assert.Error(t, err)
+}
+
+func TestEndpointAddress(t *testing.T) {
+	tests := map[string]string{
+		"https://10.0.0.1":        "10.0.0.1:443",
+		"https://10.0.0.1/api":    "10.0.0.1:443",
+		"10.0.0.1:8443":           "10.0.0.1:8443",
+		"https://unity.local:444": "unity.local:444",
+		"https://[fd00::1]":       "[fd00::1]:443",
+	}
+	for endpoint, expected := range tests {
+		address, err := endpointAddress(endpoint)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, address)
+	}
+	_, err := endpointAddress("https://")
+	assert.Error(t, err)
+}
@@ -0,0 +1,476 @@
+/*
+ Copyright © 2025 Dell Inc. or its subsidiaries. All Rights Reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/dell/gounity"
+	"github.com/dell/gounity/api"
+	types "github.com/dell/gounity/apitypes"
+	util "github.com/dell/gounity/gounityutil"
+)
+
+// volumesResult lists the LUNs with the names of the hosts they are exported to
+func volumesResult(ctx context.Context, client gounity.UnityClient, volumes ...types.VolumeContent) (*result, error) {
+	hostNames, err := volumeHostNames(ctx, client, volumes)
+	if err != nil {
+		return nil, err
+	}
+	res := &result{value: volumes, header: []string{"ID", "NAME", "POOL", "SIZE", "USED", "THIN", "WWN", "HOSTS"}}
+	for _, v := range volumes {
+		hosts := make([]string, 0, len(v.HostAccessResponse))
+		for _, access := range v.HostAccessResponse {
+			host := access.HostContent.ID
+			if name := hostNames[host]; name != "" {
+				host = name
+			}
+			hosts = append(hosts, host)
+		}
+		sort.Strings(hosts)
+		res.rows = append(res.rows, []string{
+			v.ResourceID, v.Name, v.Pool.ID, formatSize(v.SizeTotal), formatSize(v.SizeUsed), formatBool(v.IsThinEnabled), v.Wwn, strings.Join(hosts, ","),
+		})
+	}
+	return res, nil
+}
+
+// volumeHostNames returns the names of the hosts by ID, only listing the hosts if a LUN is exported
+func volumeHostNames(ctx context.Context, client gounity.UnityClient, volumes []types.VolumeContent) (map[string]string, error) {
+	if !slices.ContainsFunc(volumes, func(v types.VolumeContent) bool { return len(v.HostAccessResponse) > 0 }) {
+		return nil, nil
+	}
+	hosts, err := client.ListHosts(ctx)
+	if err != nil {
+		return nil, err
+	}
+	names := make(map[string]string, len(hosts))
+	for _, host := range hosts {
+		names[host.HostContent.ID] = host.HostContent.Name
+	}
+	return names, nil
+}
+
+func volumesList(flags *flag.FlagSet) runFunc {
+	pool := flags.String("pool", "", "only list the LUNs of the pool with this name")
+	return func(ctx context.Context, client gounity.UnityClient) (*result, error) {
+		poolID := ""
+		if *pool != "" {
+			storagePool, err := client.FindStoragePoolByName(ctx, *pool)
+			if err != nil {
+				return nil, err
+			}
+			poolID = storagePool.StoragePoolContent.ID
+		}
+		volumes, _, err := client.ListVolumes(ctx, 0, 0)
+		if err != nil {
+			return nil, err
+		}
+		contents := []types.VolumeContent{}
+		for _, volume := range volumes {
+			if poolID == "" || volume.VolumeContent.Pool.ID == poolID {
+				contents = append(contents, volume.VolumeContent)
+			}
+		}
+		return volumesResult(ctx, client, contents...)
+	}
+}
+
+// volumeFlags registers the flags identifying a LUN
+func volumeFlags(flags *flag.FlagSet) func(ctx context.Context, client gounity.UnityClient) (*types.Volume, error) {
+	id := flags.String("id", "", "ID of the LUN")
+	name := flags.String("name", "", "name of the LUN")
+	return func(ctx context.Context, client gounity.UnityClient) (*types.Volume, error) {
+		return find(ctx, *id, *name, client.FindVolumeByID, client.FindVolumeByName)
+	}
+}
+
+func volumesGet(flags *flag.FlagSet) runFunc {
+	findVolume := volumeFlags(flags)
+	return func(ctx context.Context, client gounity.UnityClient) (*result, error) {
+		volume, err := findVolume(ctx, client)
+		if err != nil {
+			return nil, err
+		}
+		return volumesResult(ctx, client, volume.VolumeContent)
+	}
+}
+
+func volumesCreate(flags *flag.FlagSet) runFunc {
+	name := flags.String("name", "", "name of the LUN")
+	pool := flags.String("pool", "", "name of the pool")
+	size := flags.String("size", "", "size of the LUN, such as 10Gi")
+	description := flags.String("description", "", "description of the LUN")
+	thin := flags.Bool("thin", true, "create a thin LUN")
+	dataReduction := flags.Bool("data-reduction", false, "enable data reduction")
+	tieringPolicy := flags.Int("tiering-policy", 0, "FAST VP tiering policy")
+	return func(ctx context.Context, client gounity.UnityClient) (*result, error) {
+		if err := errors.Join(required("name", *name), required("pool", *pool), required("size", *size)); err != nil {
+			return nil, err
+		}
+		bytes, err := util.ParseSize(*size)
+		if err != nil {
+			return nil, err
+		}
+		storagePool, err := client.FindStoragePoolByName(ctx, *pool)
+		if err != nil {
+			return nil, err
+		}
+		volume, err := client.CreateLun(ctx, *name, storagePool.StoragePoolContent.ID, *description, bytes, *tieringPolicy, "", *thin, *dataReduction)
+		if err != nil {
+			return nil, err
+		}
+		return volumesResult(ctx, client, volume.VolumeContent)
+	}
+}
+
+func volumesDelete(flags *flag.FlagSet) runFunc {
+	findVolume := volumeFlags(flags)
+	return func(ctx context.Context, client gounity.UnityClient) (*result, error) {
+		volume, err := findVolume(ctx, client)
+		if err != nil {
+			return nil, err
+		}
+		if err := client.DeleteVolume(ctx, volume.VolumeContent.ResourceID); err != nil {
+			return nil, err
+		}
+		return statusResult("volume", volume.VolumeContent.ResourceID, "deleted"), nil
+	}
+}
+
+func volumesExport(flags *flag.FlagSet) runFunc {
+	findVolume := volumeFlags(flags)
+	host := flags.String("host", "", "name of the host to export the LUN to")
+	return func(ctx context.Context, client gounity.UnityClient) (*result, error) {
+		if err := required("host", *host); err != nil {
+			return nil, err
+		}
+		volume, err := findVolume(ctx, client)
+		if err != nil {
+			return nil, err
+		}
+		targetHost, err := client.FindHostByName(ctx, *host)
+		if err != nil {
+			return nil, err
+		}
+		if err := client.ExportVolume(ctx, volume.VolumeContent.ResourceID, targetHost.HostContent.ID); err != nil {
+			return nil, err
+		}
+		return statusResult("volume", volume.VolumeContent.ResourceID, "exported to "+*host), nil
+	}
+}
+
+func volumesUnexport(flags *flag.FlagSet) runFunc {
+	findVolume := volumeFlags(flags)
+	return func(ctx context.Context, client gounity.UnityClient) (*result, error) {
+		volume, err := findVolume(ctx, client)
+		if err != nil {
+			return nil, err
+		}
+		if err := client.UnexportVolume(ctx, volume.VolumeContent.ResourceID); err != nil {
+			return nil, err
+		}
+		return statusResult("volume", volume.VolumeContent.ResourceID, "unexported"), nil
+	}
+}
+
+func snapshotsResult(snapshots ...types.SnapshotContent) *result {
+	res := &result{value: snapshots, header: []string{"ID", "NAME", "SOURCE", "SIZE", "CREATED", "EXPIRES", "AUTO_DELETE"}}
+	for _, s := range snapshots {
+		res.rows = append(res.rows, []string{
+			s.ResourceID, s.Name, s.StorageResource.ID, formatSize(uint64(s.Size)), formatTime(s.CreationTime), formatTime(s.ExpirationTime), // #nosec G115
+			formatBool(s.IsAutoDelete),
+		})
+	}
+	return res
+}
+
+func snapshotsList(flags *flag.FlagSet) runFunc {
+	source := flags.String("source", "", "only list the snapshots of the storage resource with this ID")
+	return func(ctx context.Context, client gounity.UnityClient) (*result, error) {
+		snapshots, _, err := client.ListSnapshots(ctx, 0, 0, *source, "")
+		if err != nil {
+			return nil, err
+		}
+		contents := make([]types.SnapshotContent, 0, len(snapshots))
+		for _, snapshot := range snapshots {
+			contents = append(contents, snapshot.SnapshotContent)
+		}
+		return snapshotsResult(contents...), nil
+	}
+}
+
+// snapshotFlags registers the flags identifying a snapshot
+func snapshotFlags(flags *flag.FlagSet) func(ctx context.Context, client gounity.UnityClient) (*types.Snapshot, error) {
+	id := flags.String("id", "", "ID of the snapshot")
+	name := flags.String("name", "", "name of the snapshot")
+	return func(ctx context.Context, client gounity.UnityClient) (*types.Snapshot, error) {
+		return find(ctx, *id, *name, client.FindSnapshotByID, client.FindSnapshotByName)
+	}
+}
+
+func snapshotsGet(flags *flag.FlagSet) runFunc {
+	findSnapshot := snapshotFlags(flags)
+	return func(ctx context.Context, client gounity.UnityClient) (*result, error) {
+		snapshot, err := findSnapshot(ctx, client)
+		if err != nil {
+			return nil, err
+		}
+		return snapshotsResult(snapshot.SnapshotContent), nil
+	}
+}
+
+func snapshotsCreate(flags *flag.FlagSet) runFunc {
+	name := flags.String("name", "", "name of the snapshot")
+	volume := flags.String("volume", "", "name of the LUN to snapshot")
+	filesystem := flags.String("filesystem", "", "name of the filesystem to snapshot")
+	description := flags.String("description", "", "description of the snapshot")
+	retention := flags.String("retention", "", "retention of the snapshot in Days:Hours:Mins:Secs, kept until deleted if empty")
+	return func(ctx context.Context, client gounity.UnityClient) (*result, error) {
+		if err := required("name", *name); err != nil {
+			return nil, err
+		}
+		var snapshot *types.Snapshot
+		switch {
+		case *volume != "" && *filesystem != "":
+			return nil, errors.New("only one of -volume and -filesystem can be given")
+		case *volume != "":
+			source, err := client.FindVolumeByName(ctx, *volume)
+			if err != nil {
+				return nil, err
+			}
+			snapshot, err = client.CreateSnapshot(ctx, source.VolumeContent.ResourceID, *name, *description, *retention)
+			if err != nil {
+				return nil, err
+			}
+		case *filesystem != "":
+			source, err := client.FindFilesystemByName(ctx, *filesystem)
+			if err != nil {
+				return nil, err
+			}
+			// protocol access lets NFS shares be created from the snapshot
+			snapshot, err = client.CreateSnapshotWithFsAccesType(ctx, source.FileContent.StorageResource.ID, *name, *description, *retention, gounity.ProtocolAccessType)
+			if err != nil {
+				return nil, err
+			}
+		default:
+			return nil, errors.New("-volume or -filesystem is required")
+		}
+		return snapshotsResult(snapshot.SnapshotContent), nil
+	}
+}
+
+func snapshotsDelete(flags *flag.FlagSet) runFunc {
+	findSnapshot := snapshotFlags(flags)
+	return func(ctx context.Context, client gounity.UnityClient) (*result, error) {
+		snapshot, err := findSnapshot(ctx, client)
+		if err != nil {
+			return nil, err
+		}
+		if err := client.DeleteSnapshot(ctx, snapshot.SnapshotContent.ResourceID); err != nil {
+			return nil, err
+		}
+		return statusResult("snapshot", snapshot.SnapshotContent.ResourceID, "deleted"), nil
+	}
+}
+
+func hostsResult(hosts ...types.HostContent) *result {
+	res := &result{value: hosts, header: []string{"ID", "NAME", "DESCRIPTION", "INITIATORS", "IP_ADDRESSES"}}
+	for _, h := range hosts {
+		var initiators, addresses []string
+		for _, initiator := range append(append([]types.Initiators{}, h.FcInitiators...), h.IscsiInitiators...) {
+			initiators = append(initiators, initiator.ID)
+		}
+		for _, port := range h.IPPorts {
+			addresses = append(addresses, port.Address)
+		}
+		res.rows = append(res.rows, []string{h.ID, h.Name, h.Description, strings.Join(initiators, ","), strings.Join(addresses, ",")})
+	}
+	return res
+}
+
+func hostsList(_ *flag.FlagSet) runFunc {
+	return func(ctx context.Context, client gounity.UnityClient) (*result, error) {
+		hosts, err := client.ListHosts(ctx)
+		if err != nil {
+			return nil, err
+		}
+		contents := make([]types.HostContent, 0, len(hosts))
+		for _, host := range hosts {
+			contents = append(contents, host.HostContent)
+		}
+		return hostsResult(contents...), nil
+	}
+}
+
+func hostsGet(flags *flag.FlagSet) runFunc {
+	name := flags.String("name", "", "name of the host")
+	return func(ctx context.Context, client gounity.UnityClient) (*result, error) {
+		if err := required("name", *name); err != nil {
+			return nil, err
+		}
+		host, err := client.FindHostByName(ctx, *name)
+		if err != nil {
+			return nil, err
+		}
+		return hostsResult(host.HostContent), nil
+	}
+}
+
+func hostsCreate(flags *flag.FlagSet) runFunc {
+	name := flags.String("name", "", "name of the host")
+	tenant := flags.String("tenant", "", "ID of the tenant of the host")
+	return func(ctx context.Context, client gounity.UnityClient) (*result, error) {
+		if err := required("name", *name); err != nil {
+			return nil, err
+		}
+		host, err := client.CreateHost(ctx, *name, *tenant)
+		if err != nil {
+			return nil, err
+		}
+		return hostsResult(host.HostContent), nil
+	}
+}
+
+func hostsDelete(flags *flag.FlagSet) runFunc {
+	name := flags.String("name", "", "name of the host")
+	return func(ctx context.Context, client gounity.UnityClient) (*result, error) {
+		if err := required("name", *name); err != nil {
+			return nil, err
+		}
+		if err := client.DeleteHost(ctx, *name); err != nil {
+			return nil, err
+		}
+		return statusResult("host", *name, "deleted"), nil
+	}
+}
+
+// initiatorTypes are the names of the Unity host initiator types
+var initiatorTypes = map[int]string{
+	1: "fc",
+	2: "iscsi",
+}
+
+func initiatorsResult(initiators ...types.HostInitiatorContent) *result {
+	res := &result{value: initiators, header: []string{"ID", "INITIATOR", "TYPE", "HOST", "HEALTH"}}
+	for _, i := range initiators {
+		initiatorType, ok := initiatorTypes[i.Type]
+		if !ok {
+			initiatorType = strconv.Itoa(i.Type)
+		}
+		res.rows = append(res.rows, []string{i.ID, i.InitiatorID, initiatorType, i.ParentHost.ID, formatHealth(i.Health.Value)})
+	}
+	return res
+}
+
+func initiatorsList(flags *flag.FlagSet) runFunc {
+	host := flags.String("host", "", "only list the initiators of the host with this name")
+	return func(ctx context.Context, client gounity.UnityClient) (*result, error) {
+		hostID := ""
+		if *host != "" {
+			parentHost, err := client.FindHostByName(ctx, *host)
+			if err != nil {
+				return nil, err
+			}
+			hostID = parentHost.HostContent.ID
+		}
+		initiators, err := client.ListHostInitiators(ctx)
+		if err != nil {
+			return nil, err
+		}
+		contents := []types.HostInitiatorContent{}
+		for _, initiator := range initiators {
+			if hostID == "" || initiator.HostInitiatorContent.ParentHost.ID == hostID {
+				contents = append(contents, initiator.HostInitiatorContent)
+			}
+		}
+		return initiatorsResult(contents...), nil
+	}
+}
+
+// initiatorFlags registers the flags identifying a host initiator
+func initiatorFlags(flags *flag.FlagSet) func(ctx context.Context, client gounity.UnityClient) (*types.HostInitiator, error) {
+	id := flags.String("id", "", "ID of the initiator")
+	name := flags.String("name", "", "WWN or IQN of the initiator")
+	return func(ctx context.Context, client gounity.UnityClient) (*types.HostInitiator, error) {
+		return find(ctx, *id, *name, client.FindHostInitiatorByID, client.FindHostInitiatorByName)
+	}
+}
+
+func initiatorsGet(flags *flag.FlagSet) runFunc {
+	findInitiator := initiatorFlags(flags)
+	return func(ctx context.Context, client gounity.UnityClient) (*result, error) {
+		initiator, err := findInitiator(ctx, client)
+		if err != nil {
+			return nil, err
+		}
+		return initiatorsResult(initiator.HostInitiatorContent), nil
+	}
+}
+
+func initiatorsCreate(flags *flag.FlagSet) runFunc {
+	host := flags.String("host", "", "name of the host of the initiator")
+	wwn := flags.String("wwn", "", "WWN of an FC initiator")
+	iqn := flags.String("iqn", "", "IQN of an iSCSI initiator")
+	return func(ctx context.Context, client gounity.UnityClient) (*result, error) {
+		if err := required("host", *host); err != nil {
+			return nil, err
+		}
+		var wwnOrIqn string
+		var initiatorType types.InitiatorType
+		switch {
+		case *wwn != "" && *iqn != "":
+			return nil, errors.New("only one of -wwn and -iqn can be given")
+		case *wwn != "":
+			wwnOrIqn, initiatorType = *wwn, api.FCInitiatorType
+		case *iqn != "":
+			wwnOrIqn, initiatorType = *iqn, api.ISCSCIInitiatorType
+		default:
+			return nil, errors.New("-wwn or -iqn is required")
+		}
+		parentHost, err := client.FindHostByName(ctx, *host)
+		if err != nil {
+			return nil, err
+		}
+		initiator, err := client.CreateHostInitiator(ctx, parentHost.HostContent.ID, wwnOrIqn, initiatorType)
+		if err != nil {
+			return nil, err
+		}
+		return initiatorsResult(initiator.HostInitiatorContent), nil
+	}
+}
+
+func initiatorsDelete(flags *flag.FlagSet) runFunc {
+	findInitiator := initiatorFlags(flags)
+	return func(ctx context.Context, client gounity.UnityClient) (*result, error) {
+		initiator, err := findInitiator(ctx, client)
+		if err != nil {
+			return nil, err
+		}
+		if initiator.HostInitiatorContent.ID == "" {
+			return nil, errors.New("unable to find initiator")
+		}
+		if err := client.DeleteHostInitiator(ctx, initiator.HostInitiatorContent.ID); err != nil {
+			return nil, err
+		}
+		return statusResult("initiator", initiator.HostInitiatorContent.ID, "deleted"), nil
+	}
+}
@@ -0,0 +1,174 @@
+/*
+ Copyright © 2025 Dell Inc. or its subsidiaries. All Rights Reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/dell/gounity"
+)
+
+// runFunc runs a command whose flags were parsed
+type runFunc func(ctx context.Context, client gounity.UnityClient) (*result, error)
+
+// command is a verb on a resource, such as "volumes list", or a resource alone, such as "capacity"
+type command struct {
+	resource string
+	verb     string
+	summary  string
+	// setup registers the flags of the command and returns the function running it
+	setup func(flags *flag.FlagSet) runFunc
+}
+
+func (c *command) name() string {
+	if c.verb == "" {
+		return c.resource
+	}
+	return c.resource + " " + c.verb
+}
+
+// commands are the commands of gounityctl in the order they are listed by the usage
+var commands = []command{
+	{"volumes", "list", "List LUNs, optionally of a pool", volumesList},
+	{"volumes", "get", "Get a LUN by ID or name", volumesGet},
+	{"volumes", "create", "Create a LUN", volumesCreate},
+	{"volumes", "delete", "Delete a LUN", volumesDelete},
+	{"volumes", "export", "Export a LUN to a host", volumesExport},
+	{"volumes", "unexport", "Unexport a LUN from all hosts", volumesUnexport},
+	{"filesystems", "list", "List filesystems, optionally of a pool", filesystemsList},
+	{"filesystems", "get", "Get a filesystem by ID or name", filesystemsGet},
+	{"filesystems", "create", "Create an NFS filesystem", filesystemsCreate},
+	{"filesystems", "delete", "Delete a filesystem", filesystemsDelete},
+	{"nfsshares", "list", "List NFS shares, optionally of a filesystem", nfsSharesList},
+	{"nfsshares", "get", "Get an NFS share by ID or name", nfsSharesGet},
+	{"nfsshares", "create", "Create an NFS share of a filesystem", nfsSharesCreate},
+	{"nfsshares", "delete", "Delete an NFS share", nfsSharesDelete},
+	{"snapshots", "list", "List snapshots, optionally of a storage resource", snapshotsList},
+	{"snapshots", "get", "Get a snapshot by ID or name", snapshotsGet},
+	{"snapshots", "create", "Create a snapshot of a LUN or filesystem", snapshotsCreate},
+	{"snapshots", "delete", "Delete a snapshot", snapshotsDelete},
+	{"hosts", "list", "List hosts", hostsList},
+	{"hosts", "get", "Get a host by name", hostsGet},
+	{"hosts", "create", "Create a host", hostsCreate},
+	{"hosts", "delete", "Delete a host", hostsDelete},
+	{"initiators", "list", "List host initiators, optionally of a host", initiatorsList},
+	{"initiators", "get", "Get a host initiator by ID or WWN/IQN", initiatorsGet},
+	{"initiators", "create", "Add an FC or iSCSI initiator to a host", initiatorsCreate},
+	{"initiators", "delete", "Delete a host initiator", initiatorsDelete},
+	{"capacity", "", "Show the capacity of the system", capacity},
+	{"metrics", "list", "List the available metrics", metricsList},
+	{"metrics", "values", "Show the historical values of a metric", metricsValues},
+	{"health", "", "Show the system health and its unhealthy components", health},
+}
+
+// findCommand returns the command named by the first arguments and the remaining arguments
+func findCommand(args []string) (*command, []string, error) {
+	if len(args) == 0 {
+		return nil, nil, errors.New("no command given")
+	}
+	for i := range commands {
+		cmd := &commands[i]
+		if cmd.resource != args[0] {
+			continue
+		}
+		if cmd.verb == "" {
+			return cmd, args[1:], nil
+		}
+		if len(args) > 1 && cmd.verb == args[1] {
+			return cmd, args[2:], nil
+		}
+	}
+	return nil, nil, fmt.Errorf("unknown command %q", strings.Join(args[:min(len(args), 2)], " "))
+}
+
+// parse parses the flags of the command
+func (c *command) parse(args []string, stderr io.Writer) (runFunc, error) {
+	flags := flag.NewFlagSet(c.name(), flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	run := c.setup(flags)
+	if err := flags.Parse(args); err != nil {
+		return nil, err
+	}
+	if flags.NArg() > 0 {
+		return nil, fmt.Errorf("unexpected arguments %s", strings.Join(flags.Args(), " "))
+	}
+	return run, nil
+}
+
+// execute runs the command named by args and writes its result in the given format.
+// connect is only called once the command line is valid.
+func execute(ctx context.Context, connect func(ctx context.Context) (gounity.UnityClient, error), args []string, format string, stdout, stderr io.Writer) error {
+	if !slices.Contains(formats, format) {
+		return fmt.Errorf("unknown output format %q, expected one of %s", format, strings.Join(formats, ", "))
+	}
+	cmd, args, err := findCommand(args)
+	if err != nil {
+		return err
+	}
+	run, err := cmd.parse(args, stderr)
+	if err != nil {
+		return err
+	}
+	client, err := connect(ctx)
+	if err != nil {
+		return err
+	}
+	// the login session is released, else every run would leave one on the array
+	defer func() {
+		if err := client.Close(); err != nil {
+			fmt.Fprintf(stderr, "Warning: unable to log out: %v\n", err)
+		}
+	}()
+	res, err := run(ctx, client)
+	if err != nil {
+		return err
+	}
+	return writeResult(stdout, format, res)
+}
+
+// required returns an error if the value of the flag is empty
+func required(flagName, value string) error {
+	if value == "" {
+		return fmt.Errorf("-%s is required", flagName)
+	}
+	return nil
+}
+
+// find looks a resource up by ID if given, else by name
+func find[T any](ctx context.Context, id, name string, byID, byName func(ctx context.Context, s string) (T, error)) (T, error) {
+	switch {
+	case id != "":
+		return byID(ctx, id)
+	case name != "":
+		return byName(ctx, name)
+	}
+	var zero T
+	return zero, errors.New("-id or -name is required")
+}
+
+// statusResult is the result of a command changing the state of a resource, such as a deletion
+func statusResult(kind, id, status string) *result {
+	return &result{
+		value:  map[string]string{"kind": kind, "id": id, "status": status},
+		header: []string{"KIND", "ID", "STATUS"},
+		rows:   [][]string{{kind, id, status}},
+	}
+}
@@ -0,0 +1,253 @@
+/*
+ Copyright © 2025 Dell Inc. or its subsidiaries. All Rights Reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"strings"
+
+	"github.com/dell/gounity"
+	types "github.com/dell/gounity/apitypes"
+	util "github.com/dell/gounity/gounityutil"
+)
+
+// nfsProtocol is the supported protocol of the filesystems created by gounityctl
+const nfsProtocol = 0
+
+func filesystemsResult(filesystems ...types.FileContent) *result {
+	res := &result{value: filesystems, header: []string{"ID", "NAME", "POOL", "NAS_SERVER", "SIZE", "USED", "THIN", "NFS_SHARES"}}
+	for _, fs := range filesystems {
+		shares := make([]string, 0, len(fs.NFSShare))
+		for _, share := range fs.NFSShare {
+			shares = append(shares, share.Name)
+		}
+		res.rows = append(res.rows, []string{
+			fs.ID, fs.Name, fs.Pool.ID, fs.NASServer.ID, formatSize(fs.SizeTotal), formatSize(fs.SizeUsed), formatBool(fs.IsThinEnabled), strings.Join(shares, ","),
+		})
+	}
+	return res
+}
+
+func filesystemsList(flags *flag.FlagSet) runFunc {
+	pool := flags.String("pool", "", "only list the filesystems of the pool with this name")
+	return func(ctx context.Context, client gounity.UnityClient) (*result, error) {
+		poolID := ""
+		if *pool != "" {
+			storagePool, err := client.FindStoragePoolByName(ctx, *pool)
+			if err != nil {
+				return nil, err
+			}
+			poolID = storagePool.StoragePoolContent.ID
+		}
+		filesystems, err := client.ListFilesystems(ctx)
+		if err != nil {
+			return nil, err
+		}
+		contents := []types.FileContent{}
+		for _, filesystem := range filesystems {
+			if poolID == "" || filesystem.FileContent.Pool.ID == poolID {
+				contents = append(contents, filesystem.FileContent)
+			}
+		}
+		return filesystemsResult(contents...), nil
+	}
+}
+
+// filesystemFlags registers the flags identifying a filesystem
+func filesystemFlags(flags *flag.FlagSet) func(ctx context.Context, client gounity.UnityClient) (*types.Filesystem, error) {
+	id := flags.String("id", "", "ID of the filesystem")
+	name := flags.String("name", "", "name of the filesystem")
+	return func(ctx context.Context, client gounity.UnityClient) (*types.Filesystem, error) {
+		return find(ctx, *id, *name, client.FindFilesystemByID, client.FindFilesystemByName)
+	}
+}
+
+func filesystemsGet(flags *flag.FlagSet) runFunc {
+	findFilesystem := filesystemFlags(flags)
+	return func(ctx context.Context, client gounity.UnityClient) (*result, error) {
+		filesystem, err := findFilesystem(ctx, client)
+		if err != nil {
+			return nil, err
+		}
+		return filesystemsResult(filesystem.FileContent), nil
+	}
+}
+
+func filesystemsCreate(flags *flag.FlagSet) runFunc {
+	name := flags.String("name", "", "name of the filesystem")
+	pool := flags.String("pool", "", "name of the pool")
+	nasServer := flags.String("nas-server", "", "ID of the NAS server")
+	size := flags.String("size", "", "size of the filesystem, such as 10Gi")
+	description := flags.String("description", "", "description of the filesystem")
+	thin := flags.Bool("thin", true, "create a thin filesystem")
+	dataReduction := flags.Bool("data-reduction", false, "enable data reduction")
+	tieringPolicy := flags.Int("tiering-policy", 0, "FAST VP tiering policy")
+	hostIOSize := flags.Int("host-io-size", 8192, "host I/O size in bytes")
+	return func(ctx context.Context, client gounity.UnityClient) (*result, error) {
+		if err := errors.Join(required("name", *name), required("pool", *pool), required("nas-server", *nasServer), required("size", *size)); err != nil {
+			return nil, err
+		}
+		bytes, err := util.ParseSize(*size)
+		if err != nil {
+			return nil, err
+		}
+		storagePool, err := client.FindStoragePoolByName(ctx, *pool)
+		if err != nil {
+			return nil, err
+		}
+		filesystem, err := client.CreateFilesystem(ctx, *name, storagePool.StoragePoolContent.ID, *description, *nasServer, bytes,
+			*tieringPolicy, *hostIOSize, nfsProtocol, *thin, *dataReduction)
+		if err != nil {
+			return nil, err
+		}
+		return filesystemsResult(filesystem.FileContent), nil
+	}
+}
+
+func filesystemsDelete(flags *flag.FlagSet) runFunc {
+	findFilesystem := filesystemFlags(flags)
+	return func(ctx context.Context, client gounity.UnityClient) (*result, error) {
+		filesystem, err := findFilesystem(ctx, client)
+		if err != nil {
+			return nil, err
+		}
+		if err := client.DeleteFilesystem(ctx, filesystem.FileContent.ID); err != nil {
+			return nil, err
+		}
+		return statusResult("filesystem", filesystem.FileContent.ID, "deleted"), nil
+	}
+}
+
+// nfsShareAccesses maps the default access names of NFS shares to the Unity values
+var nfsShareAccesses = map[string]gounity.NFSShareDefaultAccess{
+	"none":          gounity.NoneDefaultAccess,
+	"readOnly":      gounity.ReadOnlyDefaultAccess,
+	"readWrite":     gounity.ReadWriteDefaultAccess,
+	"readOnlyRoot":  gounity.ReadOnlyRootDefaultAccess,
+	"readWriteRoot": gounity.ReadWriteRootDefaultAccess,
+}
+
+// nfsShareSummary is an NFS share as listed by the filesystems
+type nfsShareSummary struct {
+	ID         string `json:"id"`
+	Name       string `json:"name"`
+	Path       string `json:"path"`
+	Filesystem string `json:"filesystem"`
+	Snapshot   string `json:"snapshot,omitempty"`
+}
+
+func nfsSharesList(flags *flag.FlagSet) runFunc {
+	filesystemName := flags.String("filesystem", "", "only list the NFS shares of the filesystem with this name")
+	return func(ctx context.Context, client gounity.UnityClient) (*result, error) {
+		var filesystems []types.Filesystem
+		if *filesystemName != "" {
+			filesystem, err := client.FindFilesystemByName(ctx, *filesystemName)
+			if err != nil {
+				return nil, err
+			}
+			filesystems = append(filesystems, *filesystem)
+		} else {
+			var err error
+			if filesystems, err = client.ListFilesystems(ctx); err != nil {
+				return nil, err
+			}
+		}
+
+		shares := []nfsShareSummary{}
+		res := &result{header: []string{"ID", "NAME", "PATH", "FILESYSTEM", "SNAPSHOT"}}
+		for _, filesystem := range filesystems {
+			for _, share := range filesystem.FileContent.NFSShare {
+				summary := nfsShareSummary{ID: share.ID, Name: share.Name, Path: share.Path, Filesystem: filesystem.FileContent.Name, Snapshot: share.ParentSnap.ID}
+				shares = append(shares, summary)
+				res.rows = append(res.rows, []string{summary.ID, summary.Name, summary.Path, summary.Filesystem, summary.Snapshot})
+			}
+		}
+		res.value = shares
+		return res, nil
+	}
+}
+
+func nfsSharesResult(shares ...types.NFSShareContent) *result {
+	res := &result{value: shares, header: []string{"ID", "NAME", "FILESYSTEM", "EXPORT_PATHS"}}
+	for _, s := range shares {
+		res.rows = append(res.rows, []string{s.ID, s.Name, s.Filesystem.ID, strings.Join(s.ExportPaths, ",")})
+	}
+	return res
+}
+
+// nfsShareFlags registers the flags identifying an NFS share
+func nfsShareFlags(flags *flag.FlagSet) func(ctx context.Context, client gounity.UnityClient) (*types.NFSShare, error) {
+	id := flags.String("id", "", "ID of the NFS share")
+	name := flags.String("name", "", "name of the NFS share")
+	return func(ctx context.Context, client gounity.UnityClient) (*types.NFSShare, error) {
+		return find(ctx, *id, *name, client.FindNFSShareByID, client.FindNFSShareByName)
+	}
+}
+
+func nfsSharesGet(flags *flag.FlagSet) runFunc {
+	findShare := nfsShareFlags(flags)
+	return func(ctx context.Context, client gounity.UnityClient) (*result, error) {
+		share, err := findShare(ctx, client)
+		if err != nil {
+			return nil, err
+		}
+		return nfsSharesResult(share.NFSShareContent), nil
+	}
+}
+
+func nfsSharesCreate(flags *flag.FlagSet) runFunc {
+	name := flags.String("name", "", "name of the NFS share")
+	filesystemName := flags.String("filesystem", "", "name of the filesystem to share")
+	path := flags.String("path", "/", "path of the share in the filesystem")
+	access := flags.String("access", "none", "default access of the share: none, readOnly, readWrite, readOnlyRoot or readWriteRoot")
+	return func(ctx context.Context, client gounity.UnityClient) (*result, error) {
+		if err := errors.Join(required("name", *name), required("filesystem", *filesystemName)); err != nil {
+			return nil, err
+		}
+		defaultAccess, ok := nfsShareAccesses[*access]
+		if !ok {
+			return nil, fmt.Errorf("unknown default access %q", *access)
+		}
+		filesystem, err := client.FindFilesystemByName(ctx, *filesystemName)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := client.CreateNFSShare(ctx, *name, *path, filesystem.FileContent.ID, defaultAccess); err != nil {
+			return nil, err
+		}
+		share, err := client.FindNFSShareByName(ctx, *name)
+		if err != nil {
+			return nil, err
+		}
+		return nfsSharesResult(share.NFSShareContent), nil
+	}
+}
+
+func nfsSharesDelete(flags *flag.FlagSet) runFunc {
+	findShare := nfsShareFlags(flags)
+	return func(ctx context.Context, client gounity.UnityClient) (*result, error) {
+		share, err := findShare(ctx, client)
+		if err != nil {
+			return nil, err
+		}
+		if err := client.DeleteNFSShare(ctx, share.NFSShareContent.Filesystem.ID, share.NFSShareContent.ID); err != nil {
+			return nil, err
+		}
+		return statusResult("nfsShare", share.NFSShareContent.ID, "deleted"), nil
+	}
+}
@@ -0,0 +1,142 @@
+/*
+ Copyright © 2025 Dell Inc. or its subsidiaries. All Rights Reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+// Command gounityctl manages the volumes, filesystems, NFS shares, snapshots, hosts and initiators of a Unity array
+// and shows its capacity, metrics and health.
+//
+//	gounityctl [flags] <resource> <verb> [command flags]
+//	gounityctl -output json volumes list -pool pool1
+//	gounityctl -profile unity2 volumes unexport -name vol1
+//
+// The array is selected from a profiles file, in the array definitions format of gounity.LoadArrayConfigs,
+// given by -profiles or GOUNITY_PROFILES. Without a profiles file, the array is given by GOUNITY_ENDPOINT and
+// GOUNITY_INSECURE, or the -endpoint and -insecure flags, with the credentials in GOUNITY_USERNAME and GOUNITY_PASSWORD.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/dell/gounity"
+	util "github.com/dell/gounity/gounityutil"
+)
+
+// EnvProfiles is the environment variable with the path of the profiles file
+const EnvProfiles = "GOUNITY_PROFILES"
+
+// settings select the array to connect to
+type settings struct {
+	profiles string
+	profile  string
+	endpoint string
+	insecure bool
+}
+
+// arrayConfigs returns the array definitions of the profiles file, or of the endpoint if there is no profiles file
+func (s *settings) arrayConfigs() ([]gounity.ArrayConfig, error) {
+	if s.profiles != "" {
+		return gounity.LoadArrayConfigsFromFile(s.profiles)
+	}
+	if s.profile != "" {
+		return nil, fmt.Errorf("profile %s requires -profiles or %s", s.profile, EnvProfiles)
+	}
+	if s.endpoint == "" {
+		return nil, fmt.Errorf("no array configured: set -profiles or %s, or -endpoint or GOUNITY_ENDPOINT", EnvProfiles)
+	}
+	return []gounity.ArrayConfig{{
+		ArrayID:            s.endpoint,
+		Endpoint:           s.endpoint,
+		Insecure:           s.insecure,
+		CredentialProvider: gounity.NewEnvCredentialProvider(gounity.EnvUsername, gounity.EnvPassword),
+	}}, nil
+}
+
+// connect returns an authenticated client of the selected array
+func (s *settings) connect(ctx context.Context) (gounity.UnityClient, error) {
+	arrays, err := s.arrayConfigs()
+	if err != nil {
+		return nil, err
+	}
+	fleet, err := gounity.NewFleet(arrays)
+	if err != nil {
+		return nil, err
+	}
+	return fleet.Client(ctx, s.profile)
+}
+
+// usage writes the flags and the commands of gounityctl
+func usage(w io.Writer, flags *flag.FlagSet) {
+	fmt.Fprintf(w, "Usage: gounityctl [flags] <resource> <verb> [command flags]\n\nFlags:\n")
+	flags.PrintDefaults()
+	fmt.Fprintf(w, "\nCommands:\n")
+	for _, cmd := range commands {
+		fmt.Fprintf(w, "  %-22s %s\n", cmd.name(), cmd.summary)
+	}
+	fmt.Fprintf(w, "\nRun 'gounityctl <resource> <verb> -h' for the flags of a command.\n")
+}
+
+// initLogger sets the log level of the library. The logger announces itself on stdout when created,
+// which would corrupt JSON and YAML output, so stdout points to stderr meanwhile.
+func initLogger(logLevel string) {
+	stdout := os.Stdout
+	os.Stdout = os.Stderr
+	defer func() { os.Stdout = stdout }()
+	util.GetLogger().SetOutput(os.Stderr)
+	util.ChangeLogLevel(logLevel)
+}
+
+// run runs gounityctl with the given arguments and returns its exit code
+func run(ctx context.Context, args []string, stdout, stderr io.Writer) int {
+	insecure, _ := strconv.ParseBool(os.Getenv("GOUNITY_INSECURE"))
+	s := &settings{}
+	flags := flag.NewFlagSet("gounityctl", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.StringVar(&s.profiles, "profiles", os.Getenv(EnvProfiles), "array definitions file, also set by "+EnvProfiles)
+	flags.StringVar(&s.profile, "profile", "", "ID of the array of the profiles file, the default array if empty")
+	flags.StringVar(&s.endpoint, "endpoint", os.Getenv("GOUNITY_ENDPOINT"), "endpoint of the array without a profiles file, also set by GOUNITY_ENDPOINT")
+	flags.BoolVar(&s.insecure, "insecure", insecure, "skip verification of the certificate of the endpoint, also set by GOUNITY_INSECURE")
+	output := flags.String("output", formatTable, "output format: "+strings.Join(formats, ", "))
+	logLevel := flags.String("log-level", "error", "log level of the library: debug, info, warn or error")
+	flags.Usage = func() { usage(stderr, flags) }
+	if err := flags.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return 0
+		}
+		return 2
+	}
+	if flags.NArg() == 0 {
+		usage(stderr, flags)
+		return 2
+	}
+	initLogger(*logLevel)
+
+	if err := execute(ctx, s.connect, flags.Args(), *output, stdout, stderr); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return 0
+		}
+		fmt.Fprintf(stderr, "Error: %v\n", err)
+		return 1
+	}
+	return 0
+}
+
+func main() {
+	os.Exit(run(context.Background(), os.Args[1:], os.Stdout, os.Stderr))
+}
@@ -0,0 +1,303 @@
+/*
+ Copyright © 2025 Dell Inc. or its subsidiaries. All Rights Reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/dell/gounity"
+	"github.com/dell/gounity/api"
+	types "github.com/dell/gounity/apitypes"
+	"github.com/dell/gounity/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// runCommand executes a command against the client and returns its output
+func runCommand(client *mocks.UnityClient, format string, args ...string) (string, error) {
+	stdout := &bytes.Buffer{}
+	connect := func(context.Context) (gounity.UnityClient, error) { return client, nil }
+	closeCall := client.On("Close").Return(nil).Maybe()
+	defer closeCall.Unset()
+	err := execute(context.Background(), connect, args, format, stdout, io.Discard)
+	return stdout.String(), err
+}
+
+func TestVolumeCommands(t *testing.T) {
+	client := &mocks.UnityClient{}
+	client.On("FindStoragePoolByName", mock.Anything, "pool1").Return(&types.StoragePool{StoragePoolContent: types.StoragePoolContent{ID: "pool_1"}}, nil)
+	client.On("ListVolumes", mock.Anything, 0, 0).Return([]types.Volume{
+		{VolumeContent: types.VolumeContent{
+			ResourceID: "sv_1", Name: "vol1", Pool: types.Pool{ID: "pool_1"}, SizeTotal: 10 << 30, SizeUsed: 1 << 20, IsThinEnabled: true,
+			HostAccessResponse: []types.HostAccessResponse{{HostContent: types.HostContent{ID: "Host_1"}}},
+		}},
+		{VolumeContent: types.VolumeContent{ResourceID: "sv_2", Name: "vol2", Pool: types.Pool{ID: "pool_2"}}},
+	}, 2, nil)
+	client.On("FindVolumeByName", mock.Anything, "vol1").Return(&types.Volume{VolumeContent: types.VolumeContent{ResourceID: "sv_1", Name: "vol1"}}, nil)
+	client.On("CreateLun", mock.Anything, "vol3", "pool_1", "", uint64(10<<30), 0, "", true, false).Return(&types.Volume{VolumeContent: types.VolumeContent{ResourceID: "sv_3", Name: "vol3"}}, nil)
+	client.On("FindHostByName", mock.Anything, "node1").Return(&types.Host{HostContent: types.HostContent{ID: "Host_1"}}, nil)
+	client.On("ExportVolume", mock.Anything, "sv_1", "Host_1").Return(nil)
+	client.On("UnexportVolume", mock.Anything, "sv_1").Return(nil)
+	client.On("ListHosts", mock.Anything).Return([]types.Host{{HostContent: types.HostContent{ID: "Host_1", Name: "node1"}}}, nil).Twice()
+
+	output, err := runCommand(client, formatTable, "volumes", "list", "-pool", "pool1")
+	assert.NoError(t, err)
+	assert.Equal(t, "ID    NAME  POOL    SIZE  USED  THIN  WWN  HOSTS\n"+
+		"sv_1  vol1  pool_1  10Gi  1Mi   true       node1\n", output)
+
+	output, err = runCommand(client, formatJSON, "volumes", "get", "-name", "vol1")
+	assert.NoError(t, err)
+	assert.Contains(t, output, `"id": "sv_1"`)
+
+	output, err = runCommand(client, formatYAML, "volumes", "list")
+	assert.NoError(t, err)
+	assert.Contains(t, output, "  id: sv_2\n")
+	assert.Contains(t, output, "  sizeTotal: 10737418240\n")
+
+	output, err = runCommand(client, formatTable, "volumes", "create", "-name", "vol3", "-pool", "pool1", "-size", "10Gi")
+	assert.NoError(t, err)
+	assert.Contains(t, output, "sv_3")
+
+	output, err = runCommand(client, formatTable, "volumes", "export", "-name", "vol1", "-host", "node1")
+	assert.NoError(t, err)
+	assert.Contains(t, output, "exported to node1")
+
+	output, err = runCommand(client, formatJSON, "volumes", "unexport", "-name", "vol1")
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"kind": "volume", "id": "sv_1", "status": "unexported"}`, output)
+
+	// Negative cases
+	_, err = runCommand(client, formatTable, "volumes", "create", "-name", "vol3")
+	assert.EqualError(t, err, "-pool is required\n-size is required")
+	_, err = runCommand(client, formatTable, "volumes", "create", "-name", "vol3", "-pool", "pool1", "-size", "10GB")
+	assert.ErrorContains(t, err, "invalid size")
+	_, err = runCommand(client, formatTable, "volumes", "delete")
+	assert.EqualError(t, err, "-id or -name is required")
+	client.On("DeleteVolume", mock.Anything, "sv_1").Return(errors.New("error"))
+	_, err = runCommand(client, formatTable, "volumes", "delete", "-name", "vol1")
+	assert.EqualError(t, err, "error")
+	client.On("ListHosts", mock.Anything).Return(nil, errors.New("unable to list hosts"))
+	_, err = runCommand(client, formatTable, "volumes", "list")
+	assert.EqualError(t, err, "unable to list hosts")
+}
+
+func TestFileCommands(t *testing.T) {
+	client := &mocks.UnityClient{}
+	filesystem := types.Filesystem{FileContent: types.FileContent{
+		ID: "fs_1", Name: "fs1", Pool: types.Pool{ID: "pool_1"}, NASServer: types.Pool{ID: "nas_1"}, StorageResource: types.Pool{ID: "res_1"},
+		NFSShare: []types.Share{{ID: "NFSShare_1", Name: "share1", Path: "/"}},
+	}}
+	client.On("ListFilesystems", mock.Anything).Return([]types.Filesystem{filesystem}, nil)
+	client.On("FindFilesystemByName", mock.Anything, "fs1").Return(&filesystem, nil)
+	client.On("FindStoragePoolByName", mock.Anything, "pool1").Return(&types.StoragePool{StoragePoolContent: types.StoragePoolContent{ID: "pool_1"}}, nil)
+	client.On("CreateFilesystem", mock.Anything, "fs2", "pool_1", "", "nas_1", uint64(1<<30), 0, 8192, nfsProtocol, true, false).Return(&types.Filesystem{FileContent: types.FileContent{ID: "fs_2"}}, nil)
+	client.On("CreateNFSShare", mock.Anything, "share2", "/", "fs_1", gounity.ReadWriteDefaultAccess).Return(&filesystem, nil)
+	share := &types.NFSShare{NFSShareContent: types.NFSShareContent{ID: "NFSShare_2", Name: "share2", Filesystem: types.Pool{ID: "fs_1"}}}
+	client.On("FindNFSShareByName", mock.Anything, "share2").Return(share, nil)
+	client.On("FindNFSShareByID", mock.Anything, "NFSShare_2").Return(share, nil)
+	client.On("DeleteNFSShare", mock.Anything, "fs_1", "NFSShare_2").Return(nil)
+	client.On("CreateSnapshotWithFsAccesType", mock.Anything, "res_1", "snap1", "", "", gounity.ProtocolAccessType).Return(&types.Snapshot{SnapshotContent: types.SnapshotContent{ResourceID: "snap_1"}}, nil)
+
+	output, err := runCommand(client, formatTable, "filesystems", "list", "-pool", "pool1")
+	assert.NoError(t, err)
+	assert.Contains(t, output, "fs_1  fs1   pool_1  nas_1")
+
+	output, err = runCommand(client, formatTable, "filesystems", "create", "-name", "fs2", "-pool", "pool1", "-nas-server", "nas_1", "-size", "1Gi")
+	assert.NoError(t, err)
+	assert.Contains(t, output, "fs_2")
+
+	output, err = runCommand(client, formatTable, "nfsshares", "list")
+	assert.NoError(t, err)
+	assert.Equal(t, "ID          NAME    PATH  FILESYSTEM  SNAPSHOT\nNFSShare_1  share1  /     fs1         \n", output)
+
+	output, err = runCommand(client, formatTable, "nfsshares", "create", "-name", "share2", "-filesystem", "fs1", "-access", "readWrite")
+	assert.NoError(t, err)
+	assert.Contains(t, output, "NFSShare_2")
+
+	_, err = runCommand(client, formatTable, "nfsshares", "delete", "-id", "NFSShare_2")
+	assert.NoError(t, err)
+	client.AssertCalled(t, "DeleteNFSShare", mock.Anything, "fs_1", "NFSShare_2")
+
+	output, err = runCommand(client, formatTable, "snapshots", "create", "-name", "snap1", "-filesystem", "fs1")
+	assert.NoError(t, err)
+	assert.Contains(t, output, "snap_1")
+
+	// Negative cases
+	_, err = runCommand(client, formatTable, "nfsshares", "create", "-name", "share2", "-filesystem", "fs1", "-access", "all")
+	assert.EqualError(t, err, `unknown default access "all"`)
+	_, err = runCommand(client, formatTable, "snapshots", "create", "-name", "snap1")
+	assert.EqualError(t, err, "-volume or -filesystem is required")
+	_, err = runCommand(client, formatTable, "snapshots", "create", "-name", "snap1", "-volume", "vol1", "-filesystem", "fs1")
+	assert.EqualError(t, err, "only one of -volume and -filesystem can be given")
+}
+
+func TestHostCommands(t *testing.T) {
+	client := &mocks.UnityClient{}
+	client.On("ListHosts", mock.Anything).Return([]types.Host{
+		{HostContent: types.HostContent{ID: "Host_1", Name: "node1", IscsiInitiators: []types.Initiators{{ID: "HostInitiator_1"}}, IPPorts: []types.IPPorts{{Address: "10.0.0.1"}}}},
+	}, nil)
+	client.On("FindHostByName", mock.Anything, "node1").Return(&types.Host{HostContent: types.HostContent{ID: "Host_1", Name: "node1"}}, nil)
+	client.On("ListHostInitiators", mock.Anything).Return([]types.HostInitiator{
+		{HostInitiatorContent: types.HostInitiatorContent{ID: "HostInitiator_1", InitiatorID: "iqn.a", Type: 2, ParentHost: types.HostContent{ID: "Host_1"}, Health: types.HealthContent{Value: gounity.HealthOK}}},
+		{HostInitiatorContent: types.HostInitiatorContent{ID: "HostInitiator_2", InitiatorID: "iqn.b", Type: 2}},
+	}, nil)
+	client.On("CreateHostInitiator", mock.Anything, "Host_1", "iqn.c", api.ISCSCIInitiatorType).Return(&types.HostInitiator{HostInitiatorContent: types.HostInitiatorContent{ID: "HostInitiator_3"}}, nil)
+	client.On("FindHostInitiatorByName", mock.Anything, "iqn.b").Return(&types.HostInitiator{HostInitiatorContent: types.HostInitiatorContent{ID: "HostInitiator_2"}}, nil)
+	client.On("DeleteHostInitiator", mock.Anything, "HostInitiator_2").Return(nil)
+
+	output, err := runCommand(client, formatTable, "hosts", "list")
+	assert.NoError(t, err)
+	assert.Contains(t, output, "Host_1  node1               HostInitiator_1  10.0.0.1")
+
+	output, err = runCommand(client, formatTable, "initiators", "list", "-host", "node1")
+	assert.NoError(t, err)
+	assert.Equal(t, "ID               INITIATOR  TYPE   HOST    HEALTH\nHostInitiator_1  iqn.a      iscsi  Host_1  ok\n", output)
+
+	output, err = runCommand(client, formatTable, "initiators", "create", "-host", "node1", "-iqn", "iqn.c")
+	assert.NoError(t, err)
+	assert.Contains(t, output, "HostInitiator_3")
+
+	output, err = runCommand(client, formatTable, "initiators", "delete", "-name", "iqn.b")
+	assert.NoError(t, err)
+	assert.Contains(t, output, "initiator  HostInitiator_2  deleted")
+
+	// Negative cases
+	_, err = runCommand(client, formatTable, "initiators", "create", "-host", "node1")
+	assert.EqualError(t, err, "-wwn or -iqn is required")
+	_, err = runCommand(client, formatTable, "hosts", "delete")
+	assert.EqualError(t, err, "-name is required")
+}
+
+func TestSystemCommands(t *testing.T) {
+	client := &mocks.UnityClient{}
+	client.On("GetCapacity", mock.Anything).Return(&types.SystemCapacityMetricsQueryResult{Entries: []types.SystemCapacityMetricsResultEntry{
+		{Content: types.SystemCapacityMetricResult{SizeTotal: 4 << 40, SizeUsed: 1 << 40, SizeFree: 3 << 40}},
+	}}, nil)
+	client.On("GetSystemHealthReport", mock.Anything).Return(&gounity.SystemHealthReport{
+		System:              gounity.ComponentHealth{Type: "system", ID: "0", Name: "unity1", Health: types.HealthContent{Value: gounity.HealthDegraded}},
+		UnhealthyComponents: []gounity.ComponentHealth{{Type: "disk", ID: "dae_0_1_disk_3", Name: "Disk 3", Health: types.HealthContent{Value: gounity.HealthCritical, Descriptions: []string{"The disk has failed."}}}},
+	}, nil)
+	client.On("ListMetricValues", mock.Anything, "sp.*.cpu.summary.utilization").Return([]types.MetricValue{
+		{Timestamp: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC), Interval: 60, Values: map[string]interface{}{"spb": 12.5, "spa": 10.0}},
+	}, nil)
+
+	output, err := runCommand(client, formatTable, "capacity")
+	assert.NoError(t, err)
+	assert.Equal(t, "TOTAL  USED  FREE  PREALLOCATED  SUBSCRIBED  LOGICAL\n4Ti    1Ti   3Ti   0             0           0\n", output)
+
+	output, err = runCommand(client, formatTable, "health")
+	assert.NoError(t, err)
+	assert.Equal(t, "TYPE    ID              NAME    HEALTH    DESCRIPTION\n"+
+		"system  0               unity1  degraded  \n"+
+		"disk    dae_0_1_disk_3  Disk 3  critical  The disk has failed.\n", output)
+
+	output, err = runCommand(client, formatTable, "metrics", "values", "-path", "sp.*.cpu.summary.utilization")
+	assert.NoError(t, err)
+	assert.Contains(t, output, "2025-01-02T03:04:05Z  60        spa=10,spb=12.5")
+
+	// Negative cases
+	_, err = runCommand(client, formatTable, "metrics", "values")
+	assert.EqualError(t, err, "-path is required")
+	client = &mocks.UnityClient{}
+	client.On("GetCapacity", mock.Anything).Return(&types.SystemCapacityMetricsQueryResult{}, nil)
+	_, err = runCommand(client, formatTable, "capacity")
+	assert.EqualError(t, err, "the array returned no system capacity")
+}
+
+func TestExecute(t *testing.T) {
+	connected := false
+	connect := func(context.Context) (gounity.UnityClient, error) {
+		connected = true
+		return nil, errors.New("unreachable")
+	}
+	ctx := context.Background()
+
+	err := execute(ctx, connect, []string{"volumes", "list"}, formatTable, io.Discard, io.Discard)
+	assert.EqualError(t, err, "unreachable")
+	assert.True(t, connected)
+
+	// the session is closed after the command, also when it fails, and a failed logout is only a warning
+	client := &mocks.UnityClient{}
+	client.On("GetCapacity", mock.Anything).Return(nil, errors.New("capacity unavailable")).Once()
+	client.On("Close").Return(errors.New("session expired")).Once()
+	stderr := &bytes.Buffer{}
+	err = execute(ctx, func(context.Context) (gounity.UnityClient, error) { return client, nil }, []string{"capacity"}, formatTable, io.Discard, stderr)
+	assert.EqualError(t, err, "capacity unavailable")
+	assert.Equal(t, "Warning: unable to log out: session expired\n", stderr.String())
+	client.AssertExpectations(t)
+
+	// Negative cases
+	connected = false
+	for args, expected := range map[string][]string{
+		`unknown command "volumes resize"`:  {"volumes", "resize"},
+		`unknown command "pools"`:           {"pools"},
+		"no command given":                  nil,
+		"unexpected arguments vol1":         {"volumes", "get", "vol1"},
+		"flag provided but not defined: -x": {"capacity", "-x"},
+	} {
+		err := execute(ctx, connect, expected, formatTable, io.Discard, io.Discard)
+		assert.EqualError(t, err, args)
+	}
+	err = execute(ctx, connect, []string{"capacity"}, "xml", io.Discard, io.Discard)
+	assert.EqualError(t, err, `unknown output format "xml", expected one of table, json, yaml`)
+	assert.False(t, connected)
+}
+
+func TestSettings(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "profiles.yaml")
+	assert.NoError(t, os.WriteFile(path, []byte("arrays:\n  - arrayId: unity1\n    endpoint: https://10.0.0.1\n    isDefault: true\n"), 0o600))
+
+	s := &settings{profiles: path}
+	arrays, err := s.arrayConfigs()
+	assert.NoError(t, err)
+	assert.Equal(t, "https://10.0.0.1", arrays[0].Endpoint)
+
+	s = &settings{endpoint: "https://10.0.0.2", insecure: true}
+	arrays, err = s.arrayConfigs()
+	assert.NoError(t, err)
+	assert.Equal(t, "https://10.0.0.2", arrays[0].ArrayID)
+	assert.True(t, arrays[0].Insecure)
+	assert.NotNil(t, arrays[0].CredentialProvider)
+
+	// Negative cases
+	_, err = (&settings{}).arrayConfigs()
+	assert.ErrorContains(t, err, "no array configured")
+	_, err = (&settings{profile: "unity1"}).arrayConfigs()
+	assert.EqualError(t, err, "profile unity1 requires -profiles or GOUNITY_PROFILES")
+	_, err = (&settings{profiles: path, profile: "unity2"}).connect(context.Background())
+	assert.ErrorIs(t, err, gounity.ErrorArrayNotFound)
+}
+
+func TestRun(t *testing.T) {
+	ctx := context.Background()
+	stderr := &bytes.Buffer{}
+	assert.Equal(t, 2, run(ctx, nil, io.Discard, stderr))
+	assert.Contains(t, stderr.String(), "volumes unexport")
+	assert.Equal(t, 0, run(ctx, []string{"-h"}, io.Discard, io.Discard))
+	assert.Equal(t, 0, run(ctx, []string{"volumes", "list", "-h"}, io.Discard, io.Discard))
+
+	// Negative cases
+	stderr.Reset()
+	assert.Equal(t, 1, run(ctx, []string{"-profiles", "", "-endpoint", "", "health"}, io.Discard, stderr))
+	assert.Contains(t, stderr.String(), "Error: no array configured")
+	assert.Equal(t, 2, run(ctx, []string{"-unknown"}, io.Discard, io.Discard))
+}
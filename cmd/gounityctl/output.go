@@ -0,0 +1,161 @@
+/*
+ Copyright © 2025 Dell Inc. or its subsidiaries. All Rights Reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/dell/gounity"
+	util "github.com/dell/gounity/gounityutil"
+	"gopkg.in/yaml.v3"
+)
+
+// Output formats
+const (
+	formatTable = "table"
+	formatJSON  = "json"
+	formatYAML  = "yaml"
+)
+
+var formats = []string{formatTable, formatJSON, formatYAML}
+
+// result is the output of a command
+type result struct {
+	// value is written in JSON and YAML output
+	value interface{}
+	// header and rows are written in table output
+	header []string
+	rows   [][]string
+}
+
+// writeResult writes the result of a command in the given format
+func writeResult(w io.Writer, format string, res *result) error {
+	switch format {
+	case formatTable:
+		writer := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(writer, strings.Join(res.header, "\t"))
+		for _, row := range res.rows {
+			fmt.Fprintln(writer, strings.Join(row, "\t"))
+		}
+		return writer.Flush()
+	case formatJSON:
+		data, err := json.MarshalIndent(res.value, "", "  ")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(w, string(data))
+		return err
+	case formatYAML:
+		// the value goes through JSON so that YAML keys are the JSON names of the Unity REST API
+		data, err := json.Marshal(res.value)
+		if err != nil {
+			return err
+		}
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.UseNumber()
+		var value interface{}
+		if err := decoder.Decode(&value); err != nil {
+			return err
+		}
+		encoder := yaml.NewEncoder(w)
+		encoder.SetIndent(2)
+		if err := encoder.Encode(yamlNumbers(value)); err != nil {
+			return err
+		}
+		return encoder.Close()
+	}
+	return fmt.Errorf("unknown output format %q, expected one of %s", format, strings.Join(formats, ", "))
+}
+
+// yamlNumbers replaces the JSON numbers of a decoded value with integers or floats, so that sizes are not
+// written in exponent notation
+func yamlNumbers(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, item := range v {
+			v[key] = yamlNumbers(item)
+		}
+	case []interface{}:
+		for i, item := range v {
+			v[i] = yamlNumbers(item)
+		}
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return i
+		}
+		if u, err := strconv.ParseUint(v.String(), 10, 64); err == nil {
+			return u
+		}
+		f, _ := v.Float64()
+		return f
+	}
+	return value
+}
+
+func formatSize(size uint64) string {
+	return util.FormatSize(size)
+}
+
+func formatBool(value bool) string {
+	return strconv.FormatBool(value)
+}
+
+func formatTime(value time.Time) string {
+	if value.IsZero() {
+		return ""
+	}
+	return value.UTC().Format(time.RFC3339)
+}
+
+// healthNames are the names of the Unity health values
+var healthNames = map[int]string{
+	gounity.HealthUnknown:        "unknown",
+	gounity.HealthOK:             "ok",
+	gounity.HealthOKBut:          "okBut",
+	gounity.HealthDegraded:       "degraded",
+	gounity.HealthMinor:          "minor",
+	gounity.HealthMajor:          "major",
+	gounity.HealthCritical:       "critical",
+	gounity.HealthNonRecoverable: "nonRecoverable",
+}
+
+func formatHealth(value int) string {
+	if name, ok := healthNames[value]; ok {
+		return name
+	}
+	return strconv.Itoa(value)
+}
+
+// formatValues joins the values of a metric by key
+func formatValues(values map[string]interface{}) string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	pairs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		pairs = append(pairs, fmt.Sprintf("%s=%v", key, values[key]))
+	}
+	return strings.Join(pairs, ",")
+}
@@ -0,0 +1,100 @@
+/*
+ Copyright © 2025 Dell Inc. or its subsidiaries. All Rights Reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"strconv"
+	"strings"
+
+	"github.com/dell/gounity"
+	types "github.com/dell/gounity/apitypes"
+)
+
+func capacity(_ *flag.FlagSet) runFunc {
+	return func(ctx context.Context, client gounity.UnityClient) (*result, error) {
+		capacity, err := client.GetCapacity(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if len(capacity.Entries) == 0 {
+			return nil, errors.New("the array returned no system capacity")
+		}
+		c := capacity.Entries[0].Content
+		return &result{
+			value:  c,
+			header: []string{"TOTAL", "USED", "FREE", "PREALLOCATED", "SUBSCRIBED", "LOGICAL"},
+			rows: [][]string{{
+				formatSize(uint64(c.SizeTotal)), formatSize(uint64(c.SizeUsed)), formatSize(uint64(c.SizeFree)), // #nosec G115
+				formatSize(uint64(c.SizePreallocated)), formatSize(uint64(c.SizeSubscribed)), formatSize(uint64(c.TotalLogicalSize)), // #nosec G115
+			}},
+		}, nil
+	}
+}
+
+func metricsList(flags *flag.FlagSet) runFunc {
+	filter := flags.String("filter", "", "Unity REST API filter, such as 'isRealtimeAvailable eq true'")
+	return func(ctx context.Context, client gounity.UnityClient) (*result, error) {
+		metrics, err := client.ListMetrics(ctx, *filter)
+		if err != nil {
+			return nil, err
+		}
+		infos := make([]types.MetricInfo, 0, len(metrics))
+		res := &result{header: []string{"ID", "PATH", "UNIT", "REALTIME", "HISTORICAL"}}
+		for _, metric := range metrics {
+			m := metric.Content
+			infos = append(infos, m)
+			res.rows = append(res.rows, []string{strconv.Itoa(m.ID), m.Path, m.UnitDisplayString, formatBool(m.IsRealtimeAvailable), formatBool(m.IsHistoricalAvailable)})
+		}
+		res.value = infos
+		return res, nil
+	}
+}
+
+func metricsValues(flags *flag.FlagSet) runFunc {
+	path := flags.String("path", "", "path of the metric, such as sp.*.storage.pool.*.sizeUsed")
+	return func(ctx context.Context, client gounity.UnityClient) (*result, error) {
+		if err := required("path", *path); err != nil {
+			return nil, err
+		}
+		values, err := client.ListMetricValues(ctx, *path)
+		if err != nil {
+			return nil, err
+		}
+		res := &result{value: values, header: []string{"TIMESTAMP", "INTERVAL", "VALUES"}}
+		for _, v := range values {
+			res.rows = append(res.rows, []string{formatTime(v.Timestamp), strconv.Itoa(v.Interval), formatValues(v.Values)})
+		}
+		return res, nil
+	}
+}
+
+func health(_ *flag.FlagSet) runFunc {
+	return func(ctx context.Context, client gounity.UnityClient) (*result, error) {
+		report, err := client.GetSystemHealthReport(ctx)
+		if err != nil {
+			return nil, err
+		}
+		res := &result{value: report, header: []string{"TYPE", "ID", "NAME", "HEALTH", "DESCRIPTION"}}
+		for _, component := range append([]gounity.ComponentHealth{report.System}, report.UnhealthyComponents...) {
+			res.rows = append(res.rows, []string{
+				component.Type, component.ID, component.Name, formatHealth(component.Health.Value), strings.Join(component.Health.Descriptions, " "),
+			})
+		}
+		return res, nil
+	}
+}
@@ -0,0 +1,211 @@
+/*
+ Copyright © 2025 Dell Inc. or its subsidiaries. All Rights Reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package gounity
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dell/gounity/api"
+	util "github.com/dell/gounity/gounityutil"
+)
+
+// CollectOptions of CollectMarkedResources
+type CollectOptions struct {
+	// DryRun reports what would be deleted without deleting anything
+	DryRun bool
+	// MinAge keeps LUNs marked for deletion more recently than this. Filesystems do not record when they were
+	// marked, so with a MinAge they are kept as CollectUnknownAge, as are LUNs whose mark has no time.
+	MinAge time.Duration
+}
+
+// CollectStatus is the outcome of collecting a marked resource
+type CollectStatus string
+
+// CollectStatus constants
+const (
+	// CollectDeleted means the resource was deleted
+	CollectDeleted CollectStatus = "deleted"
+	// CollectWouldDelete means the resource is free and would be deleted without DryRun
+	CollectWouldDelete CollectStatus = "wouldDelete"
+	// CollectTooRecent means the resource was marked more recently than MinAge
+	CollectTooRecent CollectStatus = "tooRecent"
+	// CollectUnknownAge means MinAge is set but it is not known when the resource was marked
+	CollectUnknownAge CollectStatus = "unknownAge"
+	// CollectBlocked means resources still depend on the resource
+	CollectBlocked CollectStatus = "blocked"
+	// CollectFailed means deleting the resource failed
+	CollectFailed CollectStatus = "failed"
+)
+
+// CollectedResource is the outcome of collecting a resource marked for deletion
+type CollectedResource struct {
+	Node *DependencyNode
+	// MarkedAt is when the resource was marked for deletion, or zero if it is not known
+	MarkedAt time.Time
+	Status   CollectStatus
+	// Blockers are the resources still depending on a blocked resource
+	Blockers []DeleteBlocker
+	Err      error
+}
+
+// CollectReport lists the outcome of every resource marked for deletion
+type CollectReport struct {
+	Resources []CollectedResource
+}
+
+// Count returns the number of resources with the given status
+func (r *CollectReport) Count(status CollectStatus) int {
+	count := 0
+	for _, resource := range r.Resources {
+		if resource.Status == status {
+			count++
+		}
+	}
+	return count
+}
+
+func (r *CollectReport) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%d resources marked for deletion: %d deleted, %d would be deleted, %d too recent, %d of unknown age, %d blocked, %d failed",
+		len(r.Resources), r.Count(CollectDeleted), r.Count(CollectWouldDelete), r.Count(CollectTooRecent), r.Count(CollectUnknownAge),
+		r.Count(CollectBlocked), r.Count(CollectFailed))
+	for _, resource := range r.Resources {
+		fmt.Fprintf(&b, "\n  %s: %s", resource.Node, resource.Status)
+		switch {
+		case resource.Err != nil:
+			fmt.Fprintf(&b, " (%v)", resource.Err)
+		case len(resource.Blockers) > 0:
+			blockers := make([]string, 0, len(resource.Blockers))
+			for _, blocker := range resource.Blockers {
+				blockers = append(blockers, fmt.Sprintf("%s %s", blocker.Node, blocker.Relation))
+			}
+			fmt.Fprintf(&b, " by %s", strings.Join(blockers, ", "))
+		}
+	}
+	return b.String()
+}
+
+// markedAt returns when a LUN was renamed with MarkVolumeForDeletion by DeleteVolume, or zero if it is not known
+func markedAt(node *DependencyNode) time.Time {
+	if node.Kind != DependencyLUN {
+		return time.Time{}
+	}
+	seconds, err := strconv.ParseInt(strings.TrimPrefix(node.Name, MarkVolumeForDeletion), 10, 64)
+	if err != nil {
+		return time.Time{}
+	}
+	return time.Unix(seconds, 0)
+}
+
+// CollectMarkedResources deletes the LUNs and filesystems which DeleteVolume and DeleteFilesystem marked for deletion
+// because of dependent clones or snapshots, once nothing depends on them anymore. Marked resources depending on each
+// other are deleted dependents first. The report tells what was deleted and why the others were left.
+func (c *UnityClientImpl) CollectMarkedResources(ctx context.Context, opts CollectOptions) (_ *CollectReport, err error) {
+	ctx, span := c.startOperation(ctx, "CollectMarkedResources")
+	defer func() { endOperation(span, err) }()
+	log := util.GetRunIDLogger(ctx)
+
+	graph, err := c.BuildDependencyGraph(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var marked []*DependencyNode
+	for _, node := range graph.Nodes {
+		if node.MarkedForDeletion {
+			marked = append(marked, node)
+		}
+	}
+	sort.Slice(marked, func(i, j int) bool { return marked[i].ID < marked[j].ID })
+
+	// removed holds the resources deleted, or which would be deleted in a dry run, so that they do not block others
+	removed := map[string]bool{}
+	blockers := func(node *DependencyNode) []DeleteBlocker {
+		plan, _ := graph.PlanDelete(node.ID)
+		var remaining []DeleteBlocker
+		for _, blocker := range plan.Blockers {
+			if !removed[blocker.Node.ID] {
+				remaining = append(remaining, blocker)
+			}
+		}
+		return remaining
+	}
+
+	results := map[string]*CollectedResource{}
+	for progress := true; progress; {
+		progress = false
+		for _, node := range marked {
+			if results[node.ID] != nil && results[node.ID].Status != CollectBlocked {
+				continue
+			}
+			result := &CollectedResource{Node: node, MarkedAt: markedAt(node)}
+			results[node.ID] = result
+			if opts.MinAge > 0 && result.MarkedAt.IsZero() {
+				result.Status = CollectUnknownAge
+				continue
+			}
+			if time.Since(result.MarkedAt) < opts.MinAge {
+				result.Status = CollectTooRecent
+				continue
+			}
+			if result.Blockers = blockers(node); len(result.Blockers) > 0 {
+				result.Status = CollectBlocked
+				continue
+			}
+
+			progress = true
+			if opts.DryRun {
+				result.Status = CollectWouldDelete
+				removed[node.ID] = true
+				continue
+			}
+			if result.Err = c.deleteMarkedResource(ctx, node); result.Err != nil {
+				log.Warnf("Unable to delete %s marked for deletion: %v", node, result.Err)
+				result.Status = CollectFailed
+				continue
+			}
+			log.Debugf("Deleted %s marked for deletion", node)
+			result.Status = CollectDeleted
+			removed[node.ID] = true
+		}
+	}
+
+	report := &CollectReport{}
+	for _, node := range marked {
+		report.Resources = append(report.Resources, *results[node.ID])
+	}
+	log.Infof("Collected resources marked for deletion: %d deleted, %d would be deleted, %d left",
+		report.Count(CollectDeleted), report.Count(CollectWouldDelete), len(marked)-report.Count(CollectDeleted)-report.Count(CollectWouldDelete))
+	return report, nil
+}
+
+// deleteMarkedResource deletes a marked LUN or filesystem. LUNs are deleted directly rather than with DeleteVolume,
+// which would also delete the marked parent of a thin clone behind the back of the sweep.
+func (c *UnityClientImpl) deleteMarkedResource(ctx context.Context, node *DependencyNode) error {
+	if node.Kind == DependencyFilesystem {
+		return c.DeleteFilesystem(ctx, node.ID)
+	}
+	defer c.resourceCache.invalidate(cachePools)
+	err := c.executeWithRetryAuthenticate(ctx, http.MethodDelete, fmt.Sprintf(api.UnityAPIGetResourceURI, api.StorageResourceAction, node.ID), nil, nil)
+	if err != nil {
+		return fmt.Errorf("delete Volume %s Failed. Error: %v", node.ID, err)
+	}
+	return nil
+}
@@ -0,0 +1,120 @@
+/*
+ Copyright © 2025 Dell Inc. or its subsidiaries. All Rights Reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package gounity
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	types "github.com/dell/gounity/apitypes"
+	mocksapi "github.com/dell/gounity/mocks/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCollectMarkedResources(t *testing.T) {
+	ctx := context.Background()
+	old := MarkVolumeForDeletion + strconv.FormatInt(time.Now().Add(-48*time.Hour).Unix(), 10)
+	recent := MarkVolumeForDeletion + strconv.FormatInt(time.Now().Unix(), 10)
+	volumes, snapshots, filesystems, sessions := dependencyFixture()
+	clone := func(id, name, parentID string) types.Volume {
+		return types.Volume{VolumeContent: types.VolumeContent{ResourceID: id, Name: name, IsThinClone: true, ParentVolume: types.StorageResource{ID: parentID}}}
+	}
+	volumes = append(volumes,
+		// sv_4 waits for its recently marked clone sv_3
+		clone("sv_3", recent, "sv_4"),
+		types.Volume{VolumeContent: types.VolumeContent{ResourceID: "sv_4", Name: old}},
+		// sv_6 is free once its marked clone sv_5 is deleted
+		clone("sv_5", old, "sv_6"),
+		types.Volume{VolumeContent: types.VolumeContent{ResourceID: "sv_6", Name: old}},
+		// sv_7 was marked without a time
+		types.Volume{VolumeContent: types.VolumeContent{ResourceID: "sv_7", Name: MarkVolumeForDeletion}},
+	)
+	filesystems = append(filesystems, types.Filesystem{FileContent: types.FileContent{
+		ID: "fs_2", Name: "fs2", Description: MarkFilesystemForDeletion, StorageResource: types.Pool{ID: "res_2"},
+	}})
+
+	newClient := func() (*UnityClientImpl, *mocksapi.Client) {
+		apiClient := &mocksapi.Client{}
+		apiClient.On("DoWithHeaders", mock.Anything, "GET", "/api/instances/filesystem/fs_2?fields="+FileSystemDisplayFields, mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+			*args.Get(5).(*types.Filesystem) = filesystems[1]
+		})
+		apiClient.On("DoWithHeaders", mock.Anything, "GET", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+			switch resp := args.Get(5).(type) {
+			case *types.ListVolumes:
+				resp.Volumes = volumes
+			case *types.ListSnapshot:
+				resp.Snapshots = snapshots
+			case *types.ListFilesystems:
+				resp.Filesystems = filesystems
+			case *types.ListReplicationSessions:
+				resp.ReplicationSessions = sessions
+			}
+		})
+		return &UnityClientImpl{api: apiClient, configConnect: &ConfigConnect{}}, apiClient
+	}
+	statuses := func(report *CollectReport) map[string]CollectStatus {
+		statuses := map[string]CollectStatus{}
+		for _, resource := range report.Resources {
+			statuses[resource.Node.ID] = resource.Status
+		}
+		return statuses
+	}
+
+	client, apiClient := newClient()
+	report, err := client.CollectMarkedResources(ctx, CollectOptions{DryRun: true, MinAge: time.Hour})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]CollectStatus{
+		"sv_1": CollectBlocked, "sv_3": CollectTooRecent, "sv_4": CollectBlocked,
+		"sv_5": CollectWouldDelete, "sv_6": CollectWouldDelete, "sv_7": CollectUnknownAge, "fs_2": CollectUnknownAge,
+	}, statuses(report))
+	assert.Equal(t, "sv_3", report.Resources[3].Blockers[0].Node.ID)
+	assert.Contains(t, report.String(), "7 resources marked for deletion: 0 deleted, 2 would be deleted, 1 too recent, 2 of unknown age, 2 blocked, 0 failed")
+	apiClient.AssertNotCalled(t, "DoWithHeaders", mock.Anything, "DELETE", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+
+	var deleted []string
+	client, apiClient = newClient()
+	apiClient.On("DoWithHeaders", mock.Anything, "DELETE", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		deleted = append(deleted, args.String(2))
+	})
+	report, err = client.CollectMarkedResources(ctx, CollectOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, 6, report.Count(CollectDeleted))
+	assert.Equal(t, []string{
+		"/api/instances/storageResource/res_2",
+		"/api/instances/storageResource/sv_3",
+		"/api/instances/storageResource/sv_4",
+		"/api/instances/storageResource/sv_5",
+		"/api/instances/storageResource/sv_6",
+		"/api/instances/storageResource/sv_7",
+	}, deleted)
+
+	// Negative cases
+	client, apiClient = newClient()
+	apiClient.On("DoWithHeaders", mock.Anything, "DELETE", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(errors.New("error"))
+	report, err = client.CollectMarkedResources(ctx, CollectOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, 4, report.Count(CollectFailed))
+	assert.Equal(t, 3, report.Count(CollectBlocked))
+
+	apiClient = &mocksapi.Client{}
+	apiClient.On("DoWithHeaders", anyArgs...).Return(errors.New("error"))
+	client = &UnityClientImpl{api: apiClient, configConnect: &ConfigConnect{}}
+	_, err = client.CollectMarkedResources(ctx, CollectOptions{})
+	assert.Error(t, err)
+}
@@ -0,0 +1,161 @@
+/*
+ Copyright © 2025 Dell Inc. or its subsidiaries. All Rights Reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package gounity
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	util "github.com/dell/gounity/gounityutil"
+	"gopkg.in/yaml.v3"
+)
+
+// Environment variables read by the environment credential provider by default
+const (
+	EnvUsername = "GOUNITY_USERNAME"
+	EnvPassword = "GOUNITY_PASSWORD" // #nosec G101
+)
+
+// Credentials holds the username and password used to login to Unity
+type Credentials struct {
+	Username string `yaml:"username" json:"username"`
+	Password string `yaml:"password" json:"password"`
+}
+
+// validate returns an error if the username or password is empty
+func (c *Credentials) validate() error {
+	if c.Username == "" || c.Password == "" {
+		return errors.New("username and password cannot be empty")
+	}
+	return nil
+}
+
+// CredentialProvider provides the credentials used at each login, so that rotated passwords
+// are picked up without recreating the client
+type CredentialProvider interface {
+	Credentials(ctx context.Context) (*Credentials, error)
+}
+
+// CredentialProviderFunc adapts a function, such as a secret manager lookup, to a CredentialProvider
+type CredentialProviderFunc func(ctx context.Context) (*Credentials, error)
+
+// Credentials calls f
+func (f CredentialProviderFunc) Credentials(ctx context.Context) (*Credentials, error) {
+	return f(ctx)
+}
+
+// staticCredentialProvider always provides the same credentials
+type staticCredentialProvider struct {
+	credentials Credentials
+}
+
+// NewStaticCredentialProvider returns a provider of the given username and password
+func NewStaticCredentialProvider(username, password string) CredentialProvider {
+	return &staticCredentialProvider{credentials: Credentials{Username: username, Password: password}}
+}
+
+func (p *staticCredentialProvider) Credentials(_ context.Context) (*Credentials, error) {
+	credentials := p.credentials
+	return &credentials, nil
+}
+
+// envCredentialProvider reads the credentials from environment variables at each call
+type envCredentialProvider struct {
+	usernameVar string
+	passwordVar string
+}
+
+// NewEnvCredentialProvider returns a provider reading the credentials from the given environment variables.
+// Empty variable names default to EnvUsername and EnvPassword.
+func NewEnvCredentialProvider(usernameVar, passwordVar string) CredentialProvider {
+	if usernameVar == "" {
+		usernameVar = EnvUsername
+	}
+	if passwordVar == "" {
+		passwordVar = EnvPassword
+	}
+	return &envCredentialProvider{usernameVar: usernameVar, passwordVar: passwordVar}
+}
+
+func (p *envCredentialProvider) Credentials(_ context.Context) (*Credentials, error) {
+	credentials := &Credentials{Username: os.Getenv(p.usernameVar), Password: os.Getenv(p.passwordVar)}
+	if err := credentials.validate(); err != nil {
+		return nil, fmt.Errorf("invalid credentials in environment variables %s and %s: %v", p.usernameVar, p.passwordVar, err)
+	}
+	return credentials, nil
+}
+
+// FileCredentialProvider reads the credentials from a YAML or JSON file with username and password keys.
+// The file is reloaded when its modification time or size changes. If the file becomes invalid or
+// unreadable, the last good credentials are provided until it is fixed.
+type FileCredentialProvider struct {
+	path        string
+	mutex       sync.Mutex
+	credentials *Credentials
+	modTime     time.Time
+	size        int64
+}
+
+// NewFileCredentialProvider returns a provider reading the credentials from the given file
+func NewFileCredentialProvider(path string) *FileCredentialProvider {
+	return &FileCredentialProvider{path: path}
+}
+
+// Credentials returns the credentials of the file, reloading it if it changed
+func (p *FileCredentialProvider) Credentials(ctx context.Context) (*Credentials, error) {
+	log := util.GetRunIDLogger(ctx)
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if err := p.reload(); err != nil {
+		if p.credentials == nil {
+			return nil, err
+		}
+		log.Warnf("Using last good credentials, %v", err)
+	}
+	credentials := *p.credentials
+	return &credentials, nil
+}
+
+// reload reads the file if it changed since it was last read. The caller must hold the mutex.
+func (p *FileCredentialProvider) reload() error {
+	info, err := os.Stat(p.path)
+	if err != nil {
+		return fmt.Errorf("unable to read credentials file %s: %v", p.path, err)
+	}
+	if p.credentials != nil && info.ModTime().Equal(p.modTime) && info.Size() == p.size {
+		return nil
+	}
+
+	data, err := os.ReadFile(p.path)
+	if err != nil {
+		return fmt.Errorf("unable to read credentials file %s: %v", p.path, err)
+	}
+	credentials := &Credentials{}
+	if err := yaml.Unmarshal(data, credentials); err != nil {
+		return fmt.Errorf("unable to parse credentials file %s: %v", p.path, err)
+	}
+	if err := credentials.validate(); err != nil {
+		return fmt.Errorf("invalid credentials file %s: %v", p.path, err)
+	}
+	p.credentials = credentials
+	p.modTime = info.ModTime()
+	p.size = info.Size()
+	return nil
+}
@@ -0,0 +1,114 @@
+/*
+ Copyright © 2025 Dell Inc. or its subsidiaries. All Rights Reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package gounity
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	mocksapi "github.com/dell/gounity/mocks/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStaticAndEnvCredentialProvider(t *testing.T) {
+	ctx := context.Background()
+	credentials, err := NewStaticCredentialProvider("admin", "password").Credentials(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, &Credentials{Username: "admin", Password: "password"}, credentials)
+
+	t.Setenv(EnvUsername, "env-admin")
+	t.Setenv(EnvPassword, "env-password")
+	credentials, err = NewEnvCredentialProvider("", "").Credentials(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, "env-admin", credentials.Username)
+
+	// Rotated passwords are read at each call
+	t.Setenv(EnvPassword, "rotated")
+	credentials, err = NewEnvCredentialProvider("", "").Credentials(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, "rotated", credentials.Password)
+
+	// Negative case
+	_, err = NewEnvCredentialProvider("GOUNITY_TEST_UNSET_USERNAME", "").Credentials(ctx)
+	assert.Error(t, err)
+}
+
+func TestFileCredentialProvider(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "credentials.yaml")
+	provider := NewFileCredentialProvider(path)
+
+	// Negative case: the file does not exist yet
+	_, err := provider.Credentials(ctx)
+	assert.Error(t, err)
+
+	require.NoError(t, os.WriteFile(path, []byte("username: admin\npassword: password\n"), 0o600))
+	credentials, err := provider.Credentials(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, "password", credentials.Password)
+
+	// The file is reloaded on change
+	require.NoError(t, os.WriteFile(path, []byte(`{"username": "admin", "password": "rotated-password"}`), 0o600))
+	require.NoError(t, os.Chtimes(path, time.Now(), time.Now().Add(time.Minute)))
+	credentials, err = provider.Credentials(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, "rotated-password", credentials.Password)
+
+	// The last good credentials are kept while the file is invalid or missing
+	require.NoError(t, os.WriteFile(path, []byte("username: admin\n"), 0o600))
+	require.NoError(t, os.Chtimes(path, time.Now(), time.Now().Add(2*time.Minute)))
+	credentials, err = provider.Credentials(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, "rotated-password", credentials.Password)
+
+	require.NoError(t, os.Remove(path))
+	credentials, err = provider.Credentials(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, "rotated-password", credentials.Password)
+}
+
+func TestAuthenticateWithCredentialProvider(t *testing.T) {
+	ctx := context.Background()
+	apiClient := &mocksapi.Client{}
+	client := &UnityClientImpl{api: apiClient, configConnect: &ConfigConnect{}}
+
+	passwords := []string{"password", "rotated"}
+	calls := 0
+	provider := CredentialProviderFunc(func(_ context.Context) (*Credentials, error) {
+		calls++
+		return &Credentials{Username: "admin", Password: passwords[calls-1]}, nil
+	})
+
+	for _, password := range passwords {
+		apiClient.On("SetToken", mock.Anything).Return()
+		apiClient.On("DoAndGetResponseBody", mock.Anything, "GET", "/api/types/loginSessionInfo", mock.MatchedBy(func(headers map[string]string) bool {
+			return headers["Authorization"] == "Basic "+basicAuth("admin", password)
+		}), mock.Anything).Return(newOKResponse(), nil).Once()
+		assert.NoError(t, client.Authenticate(ctx, &ConfigConnect{CredentialProvider: provider}))
+	}
+	assert.Equal(t, 2, calls)
+
+	// Negative case
+	err := client.Authenticate(ctx, &ConfigConnect{CredentialProvider: CredentialProviderFunc(func(_ context.Context) (*Credentials, error) {
+		return nil, errors.New("secret not found")
+	})})
+	assert.Error(t, err)
+}
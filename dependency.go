@@ -0,0 +1,305 @@
+/*
+ Copyright © 2025 Dell Inc. or its subsidiaries. All Rights Reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package gounity
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	types "github.com/dell/gounity/apitypes"
+	util "github.com/dell/gounity/gounityutil"
+)
+
+// DependencyKind is the kind of a node of a dependency graph
+type DependencyKind string
+
+// DependencyKind constants
+const (
+	DependencyLUN                DependencyKind = "lun"
+	DependencySnapshot           DependencyKind = "snapshot"
+	DependencyFilesystem         DependencyKind = "filesystem"
+	DependencyNFSShare           DependencyKind = "nfsShare"
+	DependencyHostAccess         DependencyKind = "hostAccess"
+	DependencyReplicationSession DependencyKind = "replicationSession"
+)
+
+// DependencyRelation is the reason a node depends on another
+type DependencyRelation string
+
+// DependencyRelation constants
+const (
+	// RelationSnapshotOf links a snapshot to its LUN or filesystem, and a snapshot copy to its source snapshot
+	RelationSnapshotOf DependencyRelation = "snapshotOf"
+	// RelationThinCloneOf links a thin clone to the snapshot it was created from. It does not block deleting the snapshot.
+	RelationThinCloneOf DependencyRelation = "thinCloneOf"
+	// RelationCloneOf links a thin clone to its original parent LUN, which cannot be deleted while the clone exists
+	RelationCloneOf DependencyRelation = "cloneOf"
+	// RelationShareOf links an NFS share to the filesystem or snapshot it exports
+	RelationShareOf DependencyRelation = "shareOf"
+	// RelationAccessOf links the access of a host to the LUN
+	RelationAccessOf DependencyRelation = "accessOf"
+	// RelationReplicates links a replication session to its source or destination storage resource
+	RelationReplicates DependencyRelation = "replicates"
+)
+
+// Blocks returns true if the dependency must be removed before the resource it depends on can be deleted
+func (r DependencyRelation) Blocks() bool {
+	return r != RelationThinCloneOf
+}
+
+// DependencyNode is a resource of a dependency graph
+type DependencyNode struct {
+	// ID is the ID of the resource. The ID of a host access is the LUN ID and the host ID joined by a slash.
+	ID   string
+	Kind DependencyKind
+	Name string
+	// MarkedForDeletion is true for LUNs renamed with MarkVolumeForDeletion and filesystems whose description holds
+	// MarkFilesystemForDeletion, which DeleteVolume and DeleteFilesystem left in place because of their dependents
+	MarkedForDeletion bool
+}
+
+func (n *DependencyNode) String() string {
+	if n.Name == "" {
+		return fmt.Sprintf("%s %s", n.Kind, n.ID)
+	}
+	return fmt.Sprintf("%s %s (%s)", n.Kind, n.Name, n.ID)
+}
+
+// Dependency is an edge of a dependency graph: the resource Dependent depends on the resource Dependency
+type Dependency struct {
+	Dependent  string
+	Dependency string
+	Relation   DependencyRelation
+}
+
+// DependencyGraph links LUNs, snapshots, thin clones, filesystems, NFS shares, host access and replication sessions
+type DependencyGraph struct {
+	Nodes        map[string]*DependencyNode
+	Dependencies []Dependency
+
+	// dependents holds the edges by the ID of the resource depended on
+	dependents map[string][]Dependency
+	// resourceIDs maps the storage resource ID of a filesystem to the filesystem ID
+	resourceIDs map[string]string
+}
+
+// NewDependencyGraph builds the dependency graph of the given resources. Links to resources which are not given,
+// such as the remote end of a replication session, are left out.
+func NewDependencyGraph(volumes []types.Volume, snapshots []types.Snapshot, filesystems []types.Filesystem, sessions []types.ReplicationSession) *DependencyGraph {
+	g := &DependencyGraph{
+		Nodes:       map[string]*DependencyNode{},
+		dependents:  map[string][]Dependency{},
+		resourceIDs: map[string]string{},
+	}
+
+	for _, volume := range volumes {
+		content := volume.VolumeContent
+		g.Nodes[content.ResourceID] = &DependencyNode{
+			ID: content.ResourceID, Kind: DependencyLUN, Name: content.Name,
+			MarkedForDeletion: strings.Contains(content.Name, MarkVolumeForDeletion),
+		}
+	}
+	for _, snapshot := range snapshots {
+		content := snapshot.SnapshotContent
+		g.Nodes[content.ResourceID] = &DependencyNode{ID: content.ResourceID, Kind: DependencySnapshot, Name: content.Name}
+	}
+	for _, filesystem := range filesystems {
+		content := filesystem.FileContent
+		g.Nodes[content.ID] = &DependencyNode{
+			ID: content.ID, Kind: DependencyFilesystem, Name: content.Name,
+			MarkedForDeletion: strings.Contains(content.Description, MarkFilesystemForDeletion),
+		}
+		if content.StorageResource.ID != "" {
+			g.resourceIDs[content.StorageResource.ID] = content.ID
+		}
+	}
+
+	for _, volume := range volumes {
+		content := volume.VolumeContent
+		if content.IsThinClone {
+			g.link(content.ResourceID, content.ParentSnap.ID, RelationThinCloneOf)
+			g.link(content.ResourceID, content.ParentVolume.ID, RelationCloneOf)
+		}
+		for _, access := range content.HostAccessResponse {
+			id := content.ResourceID + "/" + access.HostContent.ID
+			g.Nodes[id] = &DependencyNode{ID: id, Kind: DependencyHostAccess, Name: access.HostContent.Name}
+			g.link(id, content.ResourceID, RelationAccessOf)
+		}
+	}
+	for _, snapshot := range snapshots {
+		content := snapshot.SnapshotContent
+		g.link(content.ResourceID, g.resolve(content.StorageResource.ID), RelationSnapshotOf)
+		g.link(content.ResourceID, content.ParentSnap.ID, RelationSnapshotOf)
+	}
+	for _, filesystem := range filesystems {
+		for _, share := range filesystem.FileContent.NFSShare {
+			g.Nodes[share.ID] = &DependencyNode{ID: share.ID, Kind: DependencyNFSShare, Name: share.Name}
+			if share.ParentSnap.ID != "" {
+				g.link(share.ID, share.ParentSnap.ID, RelationShareOf)
+			} else {
+				g.link(share.ID, filesystem.FileContent.ID, RelationShareOf)
+			}
+		}
+	}
+	for _, session := range sessions {
+		content := session.ReplicationSessionContent
+		g.Nodes[content.ID] = &DependencyNode{ID: content.ID, Kind: DependencyReplicationSession, Name: content.Name}
+		g.link(content.ID, g.resolve(content.SrcResourceID), RelationReplicates)
+		g.link(content.ID, g.resolve(content.DstResourceID), RelationReplicates)
+	}
+
+	for id := range g.dependents {
+		sort.Slice(g.dependents[id], func(i, j int) bool {
+			return g.dependents[id][i].Dependent < g.dependents[id][j].Dependent
+		})
+	}
+	return g
+}
+
+// resolve returns the ID of the node of a resource ID or storage resource ID
+func (g *DependencyGraph) resolve(id string) string {
+	if fsID, ok := g.resourceIDs[id]; ok {
+		return fsID
+	}
+	return id
+}
+
+// link adds an edge if both resources are in the graph
+func (g *DependencyGraph) link(dependent, dependency string, relation DependencyRelation) {
+	if g.Nodes[dependent] == nil || g.Nodes[dependency] == nil || dependent == dependency {
+		return
+	}
+	edge := Dependency{Dependent: dependent, Dependency: dependency, Relation: relation}
+	g.Dependencies = append(g.Dependencies, edge)
+	g.dependents[dependency] = append(g.dependents[dependency], edge)
+}
+
+// Dependents returns the edges of the resources depending on the resource, sorted by dependent ID
+func (g *DependencyGraph) Dependents(resourceID string) []Dependency {
+	return g.dependents[g.resolve(resourceID)]
+}
+
+// DeleteBlocker is a resource which must be removed before another can be deleted
+type DeleteBlocker struct {
+	Node     *DependencyNode
+	Relation DependencyRelation
+}
+
+// DeletePlan explains what blocks the deletion of a resource and the order in which to remove things
+type DeletePlan struct {
+	Resource *DependencyNode
+	// Blockers are the resources directly depending on the resource
+	Blockers []DeleteBlocker
+	// Order lists every resource to remove, dependents first and the resource last. A host access is removed by
+	// unexporting the LUN from the host.
+	Order []*DependencyNode
+}
+
+// Blocked returns true if the resource cannot be deleted right away
+func (p *DeletePlan) Blocked() bool {
+	return len(p.Blockers) > 0
+}
+
+func (p *DeletePlan) String() string {
+	var b strings.Builder
+	switch {
+	case !p.Blocked():
+		fmt.Fprintf(&b, "%s can be deleted", p.Resource)
+	case p.Resource.MarkedForDeletion:
+		fmt.Fprintf(&b, "%s is marked for deletion, which is deferred until these are removed:", p.Resource)
+	default:
+		fmt.Fprintf(&b, "%s is blocked by:", p.Resource)
+	}
+	for _, blocker := range p.Blockers {
+		fmt.Fprintf(&b, "\n  %s %s", blocker.Node, blocker.Relation)
+	}
+	if p.Blocked() {
+		b.WriteString("\nSafe deletion order:")
+		for i, node := range p.Order {
+			fmt.Fprintf(&b, "\n  %d. %s", i+1, node)
+		}
+	}
+	return b.String()
+}
+
+// PlanDelete returns what blocks the deletion of the resource and the order in which its dependents and then the
+// resource can be removed. The resource is given by its ID; a filesystem can also be given by its storage resource ID.
+func (g *DependencyGraph) PlanDelete(resourceID string) (*DeletePlan, error) {
+	node := g.Nodes[g.resolve(resourceID)]
+	if node == nil {
+		return nil, fmt.Errorf("resource %s not found in dependency graph", resourceID)
+	}
+
+	plan := &DeletePlan{Resource: node}
+	for _, edge := range g.dependents[node.ID] {
+		if edge.Relation.Blocks() {
+			plan.Blockers = append(plan.Blockers, DeleteBlocker{Node: g.Nodes[edge.Dependent], Relation: edge.Relation})
+		}
+	}
+
+	visited := map[string]bool{}
+	var visit func(id string)
+	visit = func(id string) {
+		if visited[id] {
+			return
+		}
+		visited[id] = true
+		for _, edge := range g.dependents[id] {
+			if edge.Relation.Blocks() {
+				visit(edge.Dependent)
+			}
+		}
+		plan.Order = append(plan.Order, g.Nodes[id])
+	}
+	visit(node.ID)
+	return plan, nil
+}
+
+// BuildDependencyGraph lists the LUNs, snapshots, filesystems and replication sessions of the array and builds
+// their dependency graph
+func (c *UnityClientImpl) BuildDependencyGraph(ctx context.Context) (*DependencyGraph, error) {
+	log := util.GetRunIDLogger(ctx)
+	volumes, _, err := c.ListVolumes(ctx, 0, 0)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list volumes: %v", err)
+	}
+	snapshots, _, err := c.ListSnapshots(ctx, 0, 0, "", "")
+	if err != nil {
+		return nil, fmt.Errorf("unable to list snapshots: %v", err)
+	}
+	filesystems, err := c.ListFilesystems(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sessions, err := c.ListReplicationSessions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	graph := NewDependencyGraph(volumes, snapshots, filesystems, sessions)
+	log.Debugf("Built dependency graph of %d resources and %d dependencies", len(graph.Nodes), len(graph.Dependencies))
+	return graph, nil
+}
+
+// PlanDelete builds the dependency graph of the array and plans the deletion of the resource,
+// see DependencyGraph.PlanDelete
+func (c *UnityClientImpl) PlanDelete(ctx context.Context, resourceID string) (*DeletePlan, error) {
+	graph, err := c.BuildDependencyGraph(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return graph.PlanDelete(resourceID)
+}
@@ -0,0 +1,132 @@
+/*
+ Copyright © 2025 Dell Inc. or its subsidiaries. All Rights Reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package gounity
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	types "github.com/dell/gounity/apitypes"
+	mocksapi "github.com/dell/gounity/mocks/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// dependencyFixture returns a base LUN marked for deletion with a snapshot, a thin clone of the snapshot exported
+// to a host and replicated, and a filesystem with a snapshot exported by an NFS share
+func dependencyFixture() ([]types.Volume, []types.Snapshot, []types.Filesystem, []types.ReplicationSession) {
+	volumes := []types.Volume{
+		{VolumeContent: types.VolumeContent{ResourceID: "sv_1", Name: MarkVolumeForDeletion + "1700000000"}},
+		{VolumeContent: types.VolumeContent{
+			ResourceID: "sv_2", Name: "clone", IsThinClone: true,
+			ParentSnap: types.ParentSnap{ID: "38654705665"}, ParentVolume: types.StorageResource{ID: "sv_1"},
+			HostAccessResponse: []types.HostAccessResponse{{HostContent: types.HostContent{ID: "Host_1", Name: "host1"}}},
+		}},
+	}
+	snapshots := []types.Snapshot{
+		{SnapshotContent: types.SnapshotContent{ResourceID: "38654705665", Name: "snap1", StorageResource: types.StorageResource{ID: "sv_1"}}},
+		{SnapshotContent: types.SnapshotContent{ResourceID: "38654705666", Name: "fssnap", StorageResource: types.StorageResource{ID: "res_1"}}},
+	}
+	filesystems := []types.Filesystem{
+		{FileContent: types.FileContent{
+			ID: "fs_1", Name: "fs", StorageResource: types.Pool{ID: "res_1"},
+			NFSShare: []types.Share{
+				{ID: "NFSShare_1", Name: "share"},
+				{ID: "NFSShare_2", Name: "snapshare", ParentSnap: types.StorageResource{ID: "38654705666"}},
+			},
+		}},
+	}
+	sessions := []types.ReplicationSession{
+		{ReplicationSessionContent: types.ReplicationSessionContent{ID: "rep_1", Name: "rep", SrcResourceID: "sv_2", DstResourceID: "sv_99"}},
+	}
+	return volumes, snapshots, filesystems, sessions
+}
+
+func nodeIDs(nodes []*DependencyNode) []string {
+	ids := make([]string, 0, len(nodes))
+	for _, node := range nodes {
+		ids = append(ids, node.ID)
+	}
+	return ids
+}
+
+func TestDependencyGraph(t *testing.T) {
+	graph := NewDependencyGraph(dependencyFixture())
+	assert.Len(t, graph.Nodes, 9)
+	assert.Len(t, graph.Dependencies, 8)
+	assert.True(t, graph.Nodes["sv_1"].MarkedForDeletion)
+	assert.Equal(t, []Dependency{
+		{Dependent: "38654705666", Dependency: "fs_1", Relation: RelationSnapshotOf},
+		{Dependent: "NFSShare_1", Dependency: "fs_1", Relation: RelationShareOf},
+	}, graph.Dependents("res_1"))
+
+	plan, err := graph.PlanDelete("sv_1")
+	assert.NoError(t, err)
+	assert.True(t, plan.Blocked())
+	assert.Len(t, plan.Blockers, 2)
+	assert.Equal(t, []string{"38654705665", "rep_1", "sv_2/Host_1", "sv_2", "sv_1"}, nodeIDs(plan.Order))
+	assert.True(t, strings.HasPrefix(plan.String(), "lun "+MarkVolumeForDeletion+"1700000000 (sv_1) is marked for deletion"))
+
+	// the thin clone does not block deleting its source snapshot
+	plan, err = graph.PlanDelete("38654705665")
+	assert.NoError(t, err)
+	assert.False(t, plan.Blocked())
+	assert.Equal(t, "snapshot snap1 (38654705665) can be deleted", plan.String())
+
+	plan, err = graph.PlanDelete("fs_1")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"NFSShare_2", "38654705666", "NFSShare_1", "fs_1"}, nodeIDs(plan.Order))
+	assert.Contains(t, plan.String(), "fs (fs_1) is blocked by:\n  snapshot fssnap (38654705666) snapshotOf\n  nfsShare share (NFSShare_1) shareOf")
+
+	// Negative case
+	_, err = graph.PlanDelete("sv_99")
+	assert.ErrorContains(t, err, "not found in dependency graph")
+}
+
+func TestPlanDelete(t *testing.T) {
+	ctx := context.Background()
+	apiClient := &mocksapi.Client{}
+	client := &UnityClientImpl{api: apiClient, configConnect: &ConfigConnect{}}
+	volumes, snapshots, filesystems, sessions := dependencyFixture()
+
+	apiClient.On("DoWithHeaders", mock.Anything, "GET", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		switch resp := args.Get(5).(type) {
+		case *types.ListVolumes:
+			resp.Volumes = volumes
+		case *types.ListSnapshot:
+			resp.Snapshots = snapshots
+		case *types.ListFilesystems:
+			resp.Filesystems = filesystems
+		case *types.ListReplicationSessions:
+			resp.ReplicationSessions = sessions
+		}
+	}).Times(4)
+	plan, err := client.PlanDelete(ctx, "sv_2")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"rep_1", "sv_2/Host_1", "sv_2"}, nodeIDs(plan.Order))
+	apiClient.AssertCalled(t, "DoWithHeaders", mock.Anything, "GET", "/api/types/replicationSession/instances?fields="+ReplicationSessionDisplayFields, mock.Anything, mock.Anything, mock.Anything)
+	apiClient.AssertCalled(t, "DoWithHeaders", mock.Anything, "GET", "/api/types/filesystem/instances?fields="+FileSystemDisplayFields, mock.Anything, mock.Anything, mock.Anything)
+
+	// Negative cases
+	apiClient.On("DoWithHeaders", anyArgs...).Return(errors.New("error"))
+	_, err = client.PlanDelete(ctx, "sv_2")
+	assert.ErrorContains(t, err, "unable to list volumes")
+	_, err = client.ListFilesystems(ctx)
+	assert.ErrorContains(t, err, "list filesystems failed")
+	_, err = client.ListReplicationSessions(ctx)
+	assert.ErrorContains(t, err, "list replication sessions failed")
+}
@@ -0,0 +1,234 @@
+/*
+ Copyright © 2025 Dell Inc. or its subsidiaries. All Rights Reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package gounity
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	types "github.com/dell/gounity/apitypes"
+	util "github.com/dell/gounity/gounityutil"
+)
+
+// FieldMismatch is an attribute of an existing resource which differs from the requested one
+type FieldMismatch struct {
+	Field     string
+	Requested interface{}
+	Actual    interface{}
+}
+
+// ErrConflict is returned by the Ensure functions when a resource with the requested name exists
+// but its attributes differ from the requested ones
+type ErrConflict struct {
+	// Resource is the kind of resource, such as volume or filesystem
+	Resource string
+	Name     string
+	// ID is the ID of the existing resource
+	ID         string
+	Mismatches []FieldMismatch
+}
+
+func (e *ErrConflict) Error() string {
+	fields := make([]string, 0, len(e.Mismatches))
+	for _, mismatch := range e.Mismatches {
+		fields = append(fields, fmt.Sprintf("%s (requested %v, actual %v)", mismatch.Field, mismatch.Requested, mismatch.Actual))
+	}
+	return fmt.Sprintf("%s %s (%s) already exists with different attributes: %s", e.Resource, e.Name, e.ID, strings.Join(fields, ", "))
+}
+
+// fieldMismatches collects the attributes differing between a request and an existing resource
+type fieldMismatches []FieldMismatch
+
+func (m *fieldMismatches) compare(field string, requested, actual interface{}) {
+	if requested != actual {
+		*m = append(*m, FieldMismatch{Field: field, Requested: requested, Actual: actual})
+	}
+}
+
+// ensure returns the resource found by find if mismatches reports no difference with the request, or creates it.
+// find returns nil without error if the resource does not exist. If the creation fails because a concurrent
+// caller created the resource in the meantime, the resource created by the other caller is returned instead.
+func ensure[T any](ctx context.Context, resource, name string, find func() (*T, error), create func() (*T, error),
+	mismatches func(*T) (string, fieldMismatches),
+) (*T, error) {
+	log := util.GetRunIDLogger(ctx)
+
+	check := func(existing *T) (*T, error) {
+		id, fields := mismatches(existing)
+		if len(fields) > 0 {
+			return nil, &ErrConflict{Resource: resource, Name: name, ID: id, Mismatches: fields}
+		}
+		log.Debugf("%s %s already exists with ID %s", resource, name, id)
+		return existing, nil
+	}
+
+	existing, err := find()
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return check(existing)
+	}
+
+	created, createErr := create()
+	if createErr == nil {
+		return created, nil
+	}
+	// the name may have been taken by a concurrent caller between the lookup and the creation
+	existing, err = find()
+	if err != nil || existing == nil {
+		return nil, createErr
+	}
+	log.Debugf("%s %s was created concurrently: %v", resource, name, createErr)
+	return check(existing)
+}
+
+// EnsureVolume returns the LUN with the given name if its pool, size, thin provisioning, data reduction, tiering
+// policy and I/O limit policy match the request, or creates it with CreateLun. ErrConflict is returned if they differ.
+func (c *UnityClientImpl) EnsureVolume(ctx context.Context, name, poolID, description string, size uint64, fastVPTieringPolicy int,
+	hostIOLimitID string, isThinEnabled, isDataReductionEnabled bool,
+) (_ *types.Volume, err error) {
+	ctx, span := c.startOperation(ctx, "EnsureVolume", attributeResourceName.String(name))
+	defer func() { endOperation(span, err) }()
+	return ensure(ctx, "volume", name,
+		func() (*types.Volume, error) {
+			volume, err := c.FindVolumeByName(ctx, name)
+			if errors.Is(err, ErrorVolumeNotFound) {
+				return nil, nil
+			}
+			return volume, err
+		},
+		func() (*types.Volume, error) {
+			return c.CreateLun(ctx, name, poolID, description, size, fastVPTieringPolicy, hostIOLimitID, isThinEnabled, isDataReductionEnabled)
+		},
+		func(volume *types.Volume) (string, fieldMismatches) {
+			content := volume.VolumeContent
+			var fields fieldMismatches
+			fields.compare("pool", poolID, content.Pool.ID)
+			fields.compare("size", size, content.SizeTotal)
+			fields.compare("isThinEnabled", isThinEnabled, content.IsThinEnabled)
+			fields.compare("isDataReductionEnabled", isDataReductionEnabled, content.IsDataReductionEnabled)
+			fields.compare("tieringPolicy", fastVPTieringPolicy, content.TieringPolicy)
+			fields.compare("ioLimitPolicy", hostIOLimitID, content.IoLimitPolicyContent.ID)
+			return content.ResourceID, fields
+		})
+}
+
+// EnsureFilesystem returns the filesystem with the given name if its pool, NAS server, size, thin provisioning,
+// data reduction, tiering policy and host I/O size match the request, or creates it with CreateFilesystem.
+// ErrConflict is returned if they differ.
+func (c *UnityClientImpl) EnsureFilesystem(ctx context.Context, name, storagepool, description, nasServer string, size uint64,
+	tieringPolicy, hostIOSize, supportedProtocol int, isThinEnabled, isDataReductionEnabled bool,
+) (_ *types.Filesystem, err error) {
+	ctx, span := c.startOperation(ctx, "EnsureFilesystem", attributeResourceName.String(name))
+	defer func() { endOperation(span, err) }()
+	return ensure(ctx, "filesystem", name,
+		func() (*types.Filesystem, error) {
+			filesystem, err := c.FindFilesystemByName(ctx, name)
+			if errors.Is(err, ErrorFilesystemNotFound) {
+				return nil, nil
+			}
+			return filesystem, err
+		},
+		func() (*types.Filesystem, error) {
+			return c.CreateFilesystem(ctx, name, storagepool, description, nasServer, size, tieringPolicy, hostIOSize, supportedProtocol, isThinEnabled, isDataReductionEnabled)
+		},
+		func(filesystem *types.Filesystem) (string, fieldMismatches) {
+			content := filesystem.FileContent
+			var fields fieldMismatches
+			fields.compare("pool", storagepool, content.Pool.ID)
+			fields.compare("nasServer", nasServer, content.NASServer.ID)
+			fields.compare("size", size, content.SizeTotal)
+			fields.compare("isThinEnabled", isThinEnabled, content.IsThinEnabled)
+			fields.compare("isDataReductionEnabled", isDataReductionEnabled, content.IsDataReductionEnabled)
+			fields.compare("tieringPolicy", uint64(tieringPolicy), content.TieringPolicy)
+			fields.compare("hostIOSize", int64(hostIOSize), content.HostIOSize)
+			return content.ID, fields
+		})
+}
+
+// EnsureSnapshot returns the snapshot with the given name if it is a snapshot of the given storage resource,
+// or creates it with CreateSnapshot. ErrConflict is returned if it belongs to another storage resource.
+func (c *UnityClientImpl) EnsureSnapshot(ctx context.Context, storageResourceID, snapshotName, description, retentionDuration string) (_ *types.Snapshot, err error) {
+	ctx, span := c.startOperation(ctx, "EnsureSnapshot", attributeResourceName.String(snapshotName))
+	defer func() { endOperation(span, err) }()
+	return ensure(ctx, "snapshot", snapshotName,
+		func() (*types.Snapshot, error) {
+			snapshot, err := c.FindSnapshotByName(ctx, snapshotName)
+			if errors.Is(err, ErrorSnapshotNotFound) {
+				return nil, nil
+			}
+			return snapshot, err
+		},
+		func() (*types.Snapshot, error) {
+			return c.CreateSnapshot(ctx, storageResourceID, snapshotName, description, retentionDuration)
+		},
+		func(snapshot *types.Snapshot) (string, fieldMismatches) {
+			content := snapshot.SnapshotContent
+			var fields fieldMismatches
+			fields.compare("storageResource", storageResourceID, content.StorageResource.ID)
+			return content.ResourceID, fields
+		})
+}
+
+// EnsureHost returns the host with the given name, or creates it with CreateHost
+func (c *UnityClientImpl) EnsureHost(ctx context.Context, hostName string, tenantID string) (_ *types.Host, err error) {
+	ctx, span := c.startOperation(ctx, "EnsureHost", attributeResourceName.String(hostName))
+	defer func() { endOperation(span, err) }()
+	return ensure(ctx, "host", hostName,
+		func() (*types.Host, error) {
+			host, err := c.FindHostByName(ctx, hostName)
+			if errors.Is(err, ErrorHostNotFound) {
+				return nil, nil
+			}
+			return host, err
+		},
+		func() (*types.Host, error) {
+			return c.CreateHost(ctx, hostName, tenantID)
+		},
+		func(host *types.Host) (string, fieldMismatches) {
+			return host.HostContent.ID, nil
+		})
+}
+
+// EnsureNFSShare returns the NFS share with the given name if it exports the given path of the given filesystem, or
+// creates it with CreateNFSShare. ErrConflict is returned if it exports another filesystem or path.
+func (c *UnityClientImpl) EnsureNFSShare(ctx context.Context, name, path, filesystemID string, nfsShareDefaultAccess NFSShareDefaultAccess) (_ *types.NFSShare, err error) {
+	ctx, span := c.startOperation(ctx, "EnsureNFSShare", attributeResourceName.String(name))
+	defer func() { endOperation(span, err) }()
+	return ensure(ctx, "NFS share", name,
+		func() (*types.NFSShare, error) {
+			nfsShare, err := c.FindNFSShareByName(ctx, name)
+			if errors.Is(err, ErrorNFSShareNotFound) {
+				return nil, nil
+			}
+			return nfsShare, err
+		},
+		func() (*types.NFSShare, error) {
+			if _, err := c.CreateNFSShare(ctx, name, path, filesystemID, nfsShareDefaultAccess); err != nil {
+				return nil, err
+			}
+			return c.FindNFSShareByName(ctx, name)
+		},
+		func(nfsShare *types.NFSShare) (string, fieldMismatches) {
+			content := nfsShare.NFSShareContent
+			var fields fieldMismatches
+			fields.compare("filesystem", filesystemID, content.Filesystem.ID)
+			fields.compare("path", path, content.Path)
+			return content.ID, fields
+		})
+}
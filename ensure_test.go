@@ -0,0 +1,174 @@
+/*
+ Copyright © 2025 Dell Inc. or its subsidiaries. All Rights Reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package gounity
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	types "github.com/dell/gounity/apitypes"
+	mocksapi "github.com/dell/gounity/mocks/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestEnsure(t *testing.T) {
+	ctx := context.Background()
+	existing := &types.Host{HostContent: types.HostContent{ID: "Host_1"}}
+	noMismatch := func(host *types.Host) (string, fieldMismatches) { return host.HostContent.ID, nil }
+	found := func() (*types.Host, error) { return existing, nil }
+	notFound := func() (*types.Host, error) { return nil, nil }
+	mustNotCreate := func() (*types.Host, error) {
+		t.Fatal("unexpected creation")
+		return nil, nil
+	}
+
+	// The existing resource is returned
+	host, err := ensure(ctx, "host", "host1", found, mustNotCreate, noMismatch)
+	assert.NoError(t, err)
+	assert.Same(t, existing, host)
+
+	// A missing resource is created
+	created := &types.Host{HostContent: types.HostContent{ID: "Host_2"}}
+	host, err = ensure(ctx, "host", "host1", notFound, func() (*types.Host, error) { return created, nil }, noMismatch)
+	assert.NoError(t, err)
+	assert.Same(t, created, host)
+
+	// A concurrent caller creates the resource between the lookup and the creation
+	lookups := 0
+	raceFind := func() (*types.Host, error) {
+		lookups++
+		if lookups == 1 {
+			return nil, nil
+		}
+		return existing, nil
+	}
+	host, err = ensure(ctx, "host", "host1", raceFind, func() (*types.Host, error) { return nil, errors.New("name already in use") }, noMismatch)
+	assert.NoError(t, err)
+	assert.Same(t, existing, host)
+
+	// Negative cases
+	_, err = ensure(ctx, "host", "host1", notFound, func() (*types.Host, error) { return nil, errors.New("create failed") }, noMismatch)
+	assert.EqualError(t, err, "create failed")
+
+	_, err = ensure(ctx, "host", "host1", func() (*types.Host, error) { return nil, errors.New("lookup failed") }, mustNotCreate, noMismatch)
+	assert.EqualError(t, err, "lookup failed")
+
+	_, err = ensure(ctx, "host", "host1", found, mustNotCreate, func(host *types.Host) (string, fieldMismatches) {
+		var fields fieldMismatches
+		fields.compare("tenant", "tenant_1", "tenant_2")
+		return host.HostContent.ID, fields
+	})
+	var conflict *ErrConflict
+	assert.ErrorAs(t, err, &conflict)
+	assert.Equal(t, []FieldMismatch{{Field: "tenant", Requested: "tenant_1", Actual: "tenant_2"}}, conflict.Mismatches)
+	assert.EqualError(t, err, "host host1 (Host_1) already exists with different attributes: tenant (requested tenant_1, actual tenant_2)")
+}
+
+func TestEnsureVolume(t *testing.T) {
+	ctx := context.Background()
+	apiClient := &mocksapi.Client{}
+	client := &UnityClientImpl{api: apiClient, configConnect: &ConfigConnect{}}
+
+	apiClient.On("DoWithHeaders", mock.Anything, "GET", "/api/instances/lun/name:vol1?fields="+LunDisplayFields, mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(*types.Volume)
+		resp.VolumeContent = types.VolumeContent{ResourceID: "sv_1", Name: "vol1", SizeTotal: 1024, Pool: types.Pool{ID: "pool_1"}, IsThinEnabled: true}
+	})
+	volume, err := client.EnsureVolume(ctx, "vol1", "pool_1", "", 1024, 0, "", true, false)
+	assert.NoError(t, err)
+	assert.Equal(t, "sv_1", volume.VolumeContent.ResourceID)
+
+	// Negative case
+	_, err = client.EnsureVolume(ctx, "vol1", "pool_2", "", 2048, 0, "", true, false)
+	var conflict *ErrConflict
+	assert.ErrorAs(t, err, &conflict)
+	assert.Equal(t, []FieldMismatch{
+		{Field: "pool", Requested: "pool_2", Actual: "pool_1"},
+		{Field: "size", Requested: uint64(2048), Actual: uint64(1024)},
+	}, conflict.Mismatches)
+
+	apiClient.On("DoWithHeaders", mock.Anything, "GET", "/api/instances/lun/name:vol2?fields="+LunDisplayFields, mock.Anything, mock.Anything, mock.Anything).Return(errors.New("connection refused"))
+	_, err = client.EnsureVolume(ctx, "vol2", "pool_1", "", 1024, 0, "", true, false)
+	assert.EqualError(t, err, "unable to find volume by name vol2")
+	apiClient.AssertNotCalled(t, "DoWithHeaders", mock.Anything, "POST", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestEnsureFilesystem(t *testing.T) {
+	ctx := context.Background()
+	apiClient := &mocksapi.Client{}
+	client := &UnityClientImpl{api: apiClient, configConnect: &ConfigConnect{}}
+
+	apiClient.On("DoWithHeaders", mock.Anything, "GET", "/api/instances/filesystem/name:fs1?fields="+FileSystemDisplayFields, mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(*types.Filesystem)
+		resp.FileContent = types.FileContent{ID: "fs_1", SizeTotal: 1024, Pool: types.Pool{ID: "pool_1"}, NASServer: types.Pool{ID: "nas_1"}, HostIOSize: 8192}
+	})
+	filesystem, err := client.EnsureFilesystem(ctx, "fs1", "pool_1", "", "nas_1", 1024, 0, 8192, 0, false, false)
+	assert.NoError(t, err)
+	assert.Equal(t, "fs_1", filesystem.FileContent.ID)
+
+	// Negative case
+	_, err = client.EnsureFilesystem(ctx, "fs1", "pool_1", "", "nas_2", 1024, 0, 8192, 0, false, true)
+	var conflict *ErrConflict
+	assert.ErrorAs(t, err, &conflict)
+	assert.Len(t, conflict.Mismatches, 2)
+	assert.Equal(t, "nasServer", conflict.Mismatches[0].Field)
+	assert.Equal(t, "isDataReductionEnabled", conflict.Mismatches[1].Field)
+}
+
+func TestEnsureSnapshotHostAndNFSShare(t *testing.T) {
+	ctx := context.Background()
+	apiClient := &mocksapi.Client{}
+	client := &UnityClientImpl{api: apiClient, configConnect: &ConfigConnect{}}
+
+	apiClient.On("DoWithHeaders", mock.Anything, "GET", "/api/instances/snap/name:snap1?fields="+SnapshotDisplayFields, mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(*types.Snapshot)
+		resp.SnapshotContent = types.SnapshotContent{ResourceID: "snap_1", StorageResource: types.StorageResource{ID: "res_1"}}
+	})
+	snapshot, err := client.EnsureSnapshot(ctx, "res_1", "snap1", "", "")
+	assert.NoError(t, err)
+	assert.Equal(t, "snap_1", snapshot.SnapshotContent.ResourceID)
+	_, err = client.EnsureSnapshot(ctx, "res_2", "snap1", "", "")
+	assert.ErrorContains(t, err, "storageResource (requested res_2, actual res_1)")
+
+	apiClient.On("DoWithHeaders", mock.Anything, "GET", "/api/instances/host/name:host1?fields="+HostfieldsToQuery, mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		args.Get(5).(*types.Host).HostContent.ID = "Host_1"
+	})
+	host, err := client.EnsureHost(ctx, "host1", "")
+	assert.NoError(t, err)
+	assert.Equal(t, "Host_1", host.HostContent.ID)
+
+	apiClient.On("DoWithHeaders", mock.Anything, "GET", "/api/instances/nfsShare/name:share1?fields="+NFSShareDisplayfields, mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		args.Get(5).(*types.NFSShare).NFSShareContent = types.NFSShareContent{ID: "NFSShare_1", Filesystem: types.Pool{ID: "fs_1"}, Path: "/"}
+	})
+	nfsShare, err := client.EnsureNFSShare(ctx, "share1", "/", "fs_1", NoneDefaultAccess)
+	assert.NoError(t, err)
+	assert.Equal(t, "NFSShare_1", nfsShare.NFSShareContent.ID)
+
+	// Negative case
+	_, err = client.EnsureNFSShare(ctx, "share1", "/", "fs_2", NoneDefaultAccess)
+	var conflict *ErrConflict
+	assert.ErrorAs(t, err, &conflict)
+	assert.Equal(t, "NFSShare_1", conflict.ID)
+
+	_, err = client.EnsureNFSShare(ctx, "share1", "/other", "fs_1", NoneDefaultAccess)
+	assert.ErrorAs(t, err, &conflict)
+	assert.Equal(t, []FieldMismatch{{Field: "path", Requested: "/other", Actual: "/"}}, conflict.Mismatches)
+
+	apiClient.On("DoWithHeaders", mock.Anything, "GET", "/api/instances/nfsShare/name:share2?fields="+NFSShareDisplayfields, mock.Anything, mock.Anything, mock.Anything).Return(errors.New("connection refused"))
+	_, err = client.EnsureNFSShare(ctx, "share2", "/", "fs_1", NoneDefaultAccess)
+	assert.ErrorContains(t, err, "connection refused")
+	apiClient.AssertNotCalled(t, "DoWithHeaders", mock.Anything, "POST", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
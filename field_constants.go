@@ -19,20 +19,23 @@ const (
 	LunDisplayFields = "id,name,description,type,wwn,sizeTotal,sizeUsed,sizeAllocated,hostAccess,pool,tieringPolicy,ioLimitPolicy,isThinEnabled,isDataReductionEnabled,isThinClone,parentSnap,originalParentLun?fields,health"
 
 	// FileSystemDisplayFields to display the File System fields
-	FileSystemDisplayFields = "id,name,description,type,sizeTotal,isThinEnabled,isDataReductionEnabled,pool,nasServer,storageResource,nfsShare?fields,cifsShare,tieringPolicy,hostIOSize,health"
+	FileSystemDisplayFields = "id,name,description,type,sizeTotal,sizeUsed,sizeAllocated,isThinEnabled,isDataReductionEnabled,pool,nasServer,storageResource,nfsShare?fields,cifsShare,tieringPolicy,hostIOSize,health"
 
 	// StorageResourceDisplayFields to display Storage Resource fields
-	StorageResourceDisplayFields = "id,name,filesystem"
+	StorageResourceDisplayFields = "id,name,filesystem,snapSchedule"
 
 	// TenantDisplayFields to display Tenants fields
 	TenantDisplayFields = "id,name"
 
 	// NFSShareDisplayfields to display the NFS Share fields
-	NFSShareDisplayfields = "id,name,filesystem,readOnlyHosts,readWriteHosts,readOnlyRootAccessHosts,rootAccessHosts,exportPaths"
+	NFSShareDisplayfields = "id,name,filesystem,path,readOnlyHosts,readWriteHosts,readOnlyRootAccessHosts,rootAccessHosts,exportPaths"
 
 	// NasServerDisplayfields to display the NAS Server fields
 	NasServerDisplayfields = "id,name,nfsServer?fields"
 
+	// ReplicationSessionDisplayFields to display the Replication Session fields
+	ReplicationSessionDisplayFields = "id,name,replicationResourceType,status,srcResourceId,dstResourceId"
+
 	// SnapshotDisplayFields to display the Snapshot fields
 	SnapshotDisplayFields = "id,name,description,storageResource?,lun,creationTime,expirationTime,lastRefreshTime,state,size,isAutoDelete,accessType,parentSnap"
 
@@ -45,6 +48,9 @@ const (
 	// LicenseInfoDisplayFields to display License Info fields
 	LicenseInfoDisplayFields = "isInstalled,isValid"
 
+	// LicenseDisplayFields to display License fields
+	LicenseDisplayFields = "id,name,isInstalled,isValid,isPermanent,version,issued,expires"
+
 	// HostInitiatorPathDisplayFields to display the HostInitiatorPath fields
 	HostInitiatorPathDisplayFields = "fcPort"
 
@@ -66,6 +72,45 @@ const (
 	// SystemCapacityFields to display system capacity details
 	SystemCapacityFields = "id,sizeFree,sizeTotal,sizeUsed,sizePreallocated,sizeSubscribed,totalLogicalSize"
 
+	// MetricFields to display the Metric fields
+	MetricFields = "id,name,path,product,type,description,isHistoricalAvailable,isRealtimeAvailable,unit,unitDisplayString,visibility"
+
+	// AlertDisplayFields to display the Alert fields
+	AlertDisplayFields = "id,timestamp,severity,component,messageId,message,description,resolution,isAcknowledged,state"
+
+	// EventDisplayFields to display the Event fields
+	EventDisplayFields = "id,node,creationTime,severity,messageId,arguments,message,username,category,source"
+
+	// PoolUnitDisplayFields to display Pool Unit fields
+	PoolUnitDisplayFields = "id,name,description,type,health,sizeTotal,tierType,wwn,pool"
+
+	// DiskGroupDisplayFields to display Disk Group fields
+	DiskGroupDisplayFields = "id,name,emcPartNumber,tierType,diskTechnology,isFASTCacheAllowable,diskSize,advertisedSize,rpm,totalDisks,unconfiguredDisks,minHotSpareCandidates"
+
+	// BasicSystemInfoFields to display the Basic System Info fields
+	BasicSystemInfoFields = "id,model,name,softwareVersion,apiVersion,earliestApiVersion"
+
+	// SystemDisplayFields to display the System fields
+	SystemDisplayFields = "id,name,model,serialNumber,platform,health"
+
+	// StorageProcessorDisplayFields to display the Storage Processor fields
+	StorageProcessorDisplayFields = "id,name,health,needsReplacement,slotNumber,emcPartNumber,emcSerialNumber,manufacturer,modelName,memorySize,isRescueMode,parentDpe"
+
+	// EnclosureDisplayFields to display the DPE and DAE fields
+	EnclosureDisplayFields = "id,name,health,needsReplacement,slotNumber,emcPartNumber,emcSerialNumber,manufacturer,model"
+
+	// DiskDisplayFields to display the Disk fields
+	DiskDisplayFields = "id,name,health,needsReplacement,slotNumber,emcPartNumber,emcSerialNumber,manufacturer,model,size,rawSize,tierType,diskTechnology,pool,isInUse"
+
+	// HardwareComponentDisplayFields to display the Power Supply, Battery and Fan fields
+	HardwareComponentDisplayFields = "id,name,health,needsReplacement,slotNumber,emcPartNumber,emcSerialNumber,manufacturer,model,parent"
+
+	// LoginSessionInfoDisplayFields to display the user, roles and idle timeout of a login session
+	LoginSessionInfoDisplayFields = "id,domain,user.id,user.name,roles.id,idleTimeout,isPasswordChangeRequired"
+
+	// SnapScheduleDisplayFields to display the Snapshot Schedule fields and the fields of its rules
+	SnapScheduleDisplayFields = "id,name,isDefault,rules.id,rules.type,rules.minute,rules.hours,rules.daysOfWeek,rules.daysOfMonth,rules.interval,rules.isAutoDelete,rules.retentionTime"
+
 	// MaximumVolumeSize to display limit and unit
 	MaximumVolumeSize = "limitValue,unit"
 )
@@ -62,6 +62,12 @@ var ErrorFilesystemNotFound = errors.New("Unable to find filesystem")
 // FilesystemNotFoundErrorCode stores error code for filesystem not found
 var FilesystemNotFoundErrorCode = "0x7d13005"
 
+// ErrorNFSShareNotFound stores error for NFS share not found
+var ErrorNFSShareNotFound = errors.New("Unable to find NFS Share")
+
+// NFSShareNotFoundErrorCode stores error code for NFS share not found
+var NFSShareNotFoundErrorCode = "0x7d13005"
+
 // AttachedSnapshotsErrorCode stores error code for attached snapshots
 var AttachedSnapshotsErrorCode = "0x6000c17"
 
@@ -102,6 +108,30 @@ func (c *UnityClientImpl) FindFilesystemByID(ctx context.Context, filesystemID s
 	return fileSystemResp, nil
 }
 
+// ListFilesystems lists all the filesystems of the array with their NFS shares
+// - Example: GET /api/types/filesystem/instances?fields=id,name,description,type,sizeTotal,...
+func (c *UnityClientImpl) ListFilesystems(ctx context.Context) ([]types.Filesystem, error) {
+	filesystemsResp := &types.ListFilesystems{}
+	err := c.executeWithRetryAuthenticate(ctx, http.MethodGet, fmt.Sprintf(api.UnityAPIInstanceTypeResourcesWithFields, api.FileSystemAction, FileSystemDisplayFields), nil, filesystemsResp)
+	if err != nil {
+		return nil, fmt.Errorf("list filesystems failed. Error: %v", err)
+	}
+	return filesystemsResp.Filesystems, nil
+}
+
+// FindStorageResourceByID - Find the storage resource of a LUN or filesystem, with its snapshot schedule
+func (c *UnityClientImpl) FindStorageResourceByID(ctx context.Context, resourceID string) (*types.StorageResourceParameters, error) {
+	if resourceID == "" {
+		return nil, errors.New("Storage Resource Id shouldn't be empty")
+	}
+	storageResourceResp := &types.StorageResourceParameters{}
+	err := c.executeWithRetryAuthenticate(ctx, http.MethodGet, fmt.Sprintf(api.UnityAPIGetResourceWithFieldsURI, api.StorageResourceAction, resourceID, StorageResourceDisplayFields), nil, storageResourceResp)
+	if err != nil {
+		return nil, fmt.Errorf("unable to find storage resource %s. Error: %v", resourceID, err)
+	}
+	return storageResourceResp, nil
+}
+
 // GetFilesystemIDFromResID - Returns the filesystem ID for the filesystem
 func (c *UnityClientImpl) GetFilesystemIDFromResID(ctx context.Context, filesystemResID string) (string, error) {
 	if filesystemResID == "" {
@@ -117,7 +147,10 @@ func (c *UnityClientImpl) GetFilesystemIDFromResID(ctx context.Context, filesyst
 }
 
 // CreateFilesystem - Create a new filesystem on the array
-func (c *UnityClientImpl) CreateFilesystem(ctx context.Context, name, storagepool, description, nasServer string, size uint64, tieringPolicy, hostIOSize, supportedProtocol int, isThinEnabled, isDataReductionEnabled bool) (*types.Filesystem, error) {
+func (c *UnityClientImpl) CreateFilesystem(ctx context.Context, name, storagepool, description, nasServer string, size uint64, tieringPolicy, hostIOSize, supportedProtocol int, isThinEnabled, isDataReductionEnabled bool) (_ *types.Filesystem, err error) {
+	ctx, span := c.startOperation(ctx, "CreateFilesystem", attributeResourceName.String(name))
+	defer func() { endOperation(span, err) }()
+	defer c.resourceCache.invalidate(cachePools)
 	log := util.GetRunIDLogger(ctx)
 	if name == "" {
 		return nil, errors.New("filesystem name should not be empty")
@@ -127,6 +160,12 @@ func (c *UnityClientImpl) CreateFilesystem(ctx context.Context, name, storagepoo
 		return nil, fmt.Errorf("filesystem name %s should not exceed %d characters", name, FsNameMaxLength)
 	}
 
+	if isDataReductionEnabled {
+		if err := c.GetCapabilities().Require(FeatureDataReduction); err != nil {
+			return nil, err
+		}
+	}
+
 	pool, err := c.FindStoragePoolByID(ctx, storagepool)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get PoolID (%s) Error:%v", storagepool, err)
@@ -206,7 +245,10 @@ func (c *UnityClientImpl) CreateFilesystem(ctx context.Context, name, storagepoo
 }
 
 // DeleteFilesystem delete by its ID. If the Filesystem is not present on the array, an error will be returned.
-func (c *UnityClientImpl) DeleteFilesystem(ctx context.Context, filesystemID string) error {
+func (c *UnityClientImpl) DeleteFilesystem(ctx context.Context, filesystemID string) (err error) {
+	ctx, span := c.startOperation(ctx, "DeleteFilesystem", attributeResourceID.String(filesystemID))
+	defer func() { endOperation(span, err) }()
+	defer c.resourceCache.invalidate(cachePools)
 	log := util.GetRunIDLogger(ctx)
 	if len(filesystemID) == 0 {
 		return errors.New("Filesystem Id cannot be empty")
@@ -254,7 +296,9 @@ func (c *UnityClientImpl) updateDescription(ctx context.Context, filesystemID, d
 }
 
 // CreateNFSShare - Create NFS Share for a File system
-func (c *UnityClientImpl) CreateNFSShare(ctx context.Context, name, path, filesystemID string, nfsShareDefaultAccess NFSShareDefaultAccess) (*types.Filesystem, error) {
+func (c *UnityClientImpl) CreateNFSShare(ctx context.Context, name, path, filesystemID string, nfsShareDefaultAccess NFSShareDefaultAccess) (_ *types.Filesystem, err error) {
+	ctx, span := c.startOperation(ctx, "CreateNFSShare", attributeResourceName.String(name))
+	defer func() { endOperation(span, err) }()
 	if len(filesystemID) == 0 {
 		return nil, errors.New("Filesystem Id cannot be empty")
 	}
@@ -293,7 +337,9 @@ func (c *UnityClientImpl) CreateNFSShare(ctx context.Context, name, path, filesy
 }
 
 // CreateNFSShareFromSnapshot - Create NFS Share for a File system Snapshot
-func (c *UnityClientImpl) CreateNFSShareFromSnapshot(ctx context.Context, name, path, snapshotID string, nfsShareDefaultAccess NFSShareDefaultAccess) (*types.NFSShare, error) {
+func (c *UnityClientImpl) CreateNFSShareFromSnapshot(ctx context.Context, name, path, snapshotID string, nfsShareDefaultAccess NFSShareDefaultAccess) (_ *types.NFSShare, err error) {
+	ctx, span := c.startOperation(ctx, "CreateNFSShareFromSnapshot", attributeResourceName.String(name))
+	defer func() { endOperation(span, err) }()
 	if len(snapshotID) == 0 {
 		return nil, errors.New("Snapshot Id cannot be empty")
 	}
@@ -310,7 +356,7 @@ func (c *UnityClientImpl) CreateNFSShareFromSnapshot(ctx context.Context, name,
 	}
 
 	nfsShareResp := &types.NFSShare{}
-	err := c.executeWithRetryAuthenticate(ctx, http.MethodPost, fmt.Sprintf(api.UnityAPIInstanceTypeResources, api.NfsShareAction), nfsShareCreateReq, nfsShareResp)
+	err = c.executeWithRetryAuthenticate(ctx, http.MethodPost, fmt.Sprintf(api.UnityAPIInstanceTypeResources, api.NfsShareAction), nfsShareCreateReq, nfsShareResp)
 	if err != nil {
 		return nil, fmt.Errorf("create NFS Share: %s failed. Error: %v", name, err)
 	}
@@ -318,7 +364,7 @@ func (c *UnityClientImpl) CreateNFSShareFromSnapshot(ctx context.Context, name,
 	return nfsShareResp, nil
 }
 
-// FindNFSShareByName - Find the NFS Share by it's name. If the NFS Share is not found, an error will be returned.
+// FindNFSShareByName - Find the NFS Share by it's name. If the NFS Share is not found, ErrorNFSShareNotFound will be returned.
 func (c *UnityClientImpl) FindNFSShareByName(ctx context.Context, nfsSharename string) (*types.NFSShare, error) {
 	if len(nfsSharename) == 0 {
 		return nil, errors.New("NFS Share Name shouldn't be empty")
@@ -326,6 +372,9 @@ func (c *UnityClientImpl) FindNFSShareByName(ctx context.Context, nfsSharename s
 	nfsShareResp := &types.NFSShare{}
 	err := c.executeWithRetryAuthenticate(ctx, http.MethodGet, fmt.Sprintf(api.UnityAPIGetResourceByNameWithFieldsURI, api.NfsShareAction, nfsSharename, NFSShareDisplayfields), nil, nfsShareResp)
 	if err != nil {
+		if strings.Contains(err.Error(), NFSShareNotFoundErrorCode) {
+			return nil, ErrorNFSShareNotFound
+		}
 		return nil, fmt.Errorf("unable to find NFS Share. Error: %v", err)
 	}
 	return nfsShareResp, nil
@@ -345,7 +394,9 @@ func (c *UnityClientImpl) FindNFSShareByID(ctx context.Context, nfsShareID strin
 }
 
 // ModifyNFSShareHostAccess - Modify the host access on NFS Share
-func (c *UnityClientImpl) ModifyNFSShareHostAccess(ctx context.Context, filesystemID, nfsShareID string, hostIDs []string, accessType AccessType) error {
+func (c *UnityClientImpl) ModifyNFSShareHostAccess(ctx context.Context, filesystemID, nfsShareID string, hostIDs []string, accessType AccessType) (err error) {
+	ctx, span := c.startOperation(ctx, "ModifyNFSShareHostAccess", attributeResourceID.String(nfsShareID))
+	defer func() { endOperation(span, err) }()
 	log := util.GetRunIDLogger(ctx)
 	if len(filesystemID) == 0 {
 		return errors.New("Filesystem Id cannot be empty")
@@ -399,7 +450,9 @@ func (c *UnityClientImpl) ModifyNFSShareHostAccess(ctx context.Context, filesyst
 }
 
 // ModifyNFSShareCreatedFromSnapshotHostAccess - Modify the host access on NFS Share
-func (c *UnityClientImpl) ModifyNFSShareCreatedFromSnapshotHostAccess(ctx context.Context, nfsShareID string, hostIDs []string, accessType AccessType) error {
+func (c *UnityClientImpl) ModifyNFSShareCreatedFromSnapshotHostAccess(ctx context.Context, nfsShareID string, hostIDs []string, accessType AccessType) (err error) {
+	ctx, span := c.startOperation(ctx, "ModifyNFSShareCreatedFromSnapshotHostAccess", attributeResourceID.String(nfsShareID))
+	defer func() { endOperation(span, err) }()
 	if nfsShareID == "" {
 		return errors.New("NFS Share Id cannot be empty")
 	}
@@ -424,7 +477,7 @@ func (c *UnityClientImpl) ModifyNFSShareCreatedFromSnapshotHostAccess(ctx contex
 		nfsShareModifyReq.RootAccessHosts = &hostsIDsContent
 	}
 
-	err := c.executeWithRetryAuthenticate(ctx, http.MethodPost, fmt.Sprintf(api.UnityModifyNFSShareURI, api.NfsShareAction, nfsShareID), nfsShareModifyReq, nil)
+	err = c.executeWithRetryAuthenticate(ctx, http.MethodPost, fmt.Sprintf(api.UnityModifyNFSShareURI, api.NfsShareAction, nfsShareID), nfsShareModifyReq, nil)
 	if err != nil {
 		return fmt.Errorf("modify NFS Share %s failed. Error: %v", nfsShareID, err)
 	}
@@ -432,7 +485,9 @@ func (c *UnityClientImpl) ModifyNFSShareCreatedFromSnapshotHostAccess(ctx contex
 }
 
 // DeleteNFSShare by its ID. If the NFSShare is not present on the array, an error will be returned.
-func (c *UnityClientImpl) DeleteNFSShare(ctx context.Context, filesystemID, nfsShareID string) error {
+func (c *UnityClientImpl) DeleteNFSShare(ctx context.Context, filesystemID, nfsShareID string) (err error) {
+	ctx, span := c.startOperation(ctx, "DeleteNFSShare", attributeResourceID.String(nfsShareID))
+	defer func() { endOperation(span, err) }()
 	log := util.GetRunIDLogger(ctx)
 
 	if len(filesystemID) == 0 {
@@ -474,12 +529,14 @@ func (c *UnityClientImpl) DeleteNFSShare(ctx context.Context, filesystemID, nfsS
 }
 
 // DeleteNFSShareCreatedFromSnapshot by its ID. If the NFSShare is not present on the array, an error will be returned.
-func (c *UnityClientImpl) DeleteNFSShareCreatedFromSnapshot(ctx context.Context, nfsShareID string) error {
+func (c *UnityClientImpl) DeleteNFSShareCreatedFromSnapshot(ctx context.Context, nfsShareID string) (err error) {
+	ctx, span := c.startOperation(ctx, "DeleteNFSShareCreatedFromSnapshot", attributeResourceID.String(nfsShareID))
+	defer func() { endOperation(span, err) }()
 	if len(nfsShareID) == 0 {
 		return errors.New("NFS Share Id cannot be empty")
 	}
 
-	_, err := c.FindNFSShareByID(ctx, nfsShareID)
+	_, err = c.FindNFSShareByID(ctx, nfsShareID)
 	if err != nil {
 		return fmt.Errorf("unable to find NFS Share %s. Error: %v", nfsShareID, err)
 	}
@@ -497,7 +554,7 @@ func (c *UnityClientImpl) FindNASServerByID(ctx context.Context, nasServerID str
 		return nil, errors.New("NAS Server Id shouldn't be empty")
 	}
 	nasServerResp := &types.NASServer{}
-	err := c.executeWithRetryAuthenticate(ctx, http.MethodGet, fmt.Sprintf(api.UnityAPIGetResourceWithFieldsURI, api.NasServerAction, nasServerID, NasServerDisplayfields), nil, nasServerResp)
+	err := c.getCached(ctx, cacheNASServers, fmt.Sprintf(api.UnityAPIGetResourceWithFieldsURI, api.NasServerAction, nasServerID, NasServerDisplayfields), nasServerResp)
 	if err != nil {
 		return nil, fmt.Errorf("unable to find NAS Server: %s. Error: %v", nasServerID, err)
 	}
@@ -505,7 +562,10 @@ func (c *UnityClientImpl) FindNASServerByID(ctx context.Context, nasServerID str
 }
 
 // ExpandFilesystem Filesystem Expand volume to provided capacity
-func (c *UnityClientImpl) ExpandFilesystem(ctx context.Context, filesystemID string, newSize uint64) error {
+func (c *UnityClientImpl) ExpandFilesystem(ctx context.Context, filesystemID string, newSize uint64) (err error) {
+	ctx, span := c.startOperation(ctx, "ExpandFilesystem", attributeResourceID.String(filesystemID))
+	defer func() { endOperation(span, err) }()
+	defer c.resourceCache.invalidate(cachePools)
 	log := util.GetRunIDLogger(ctx)
 	filesystem, err := c.FindFilesystemByID(ctx, filesystemID)
 	if err != nil {
@@ -526,6 +586,26 @@ func (c *UnityClientImpl) ExpandFilesystem(ctx context.Context, filesystemID str
 	return c.executeWithRetryAuthenticate(ctx, http.MethodPost, fmt.Sprintf(api.UnityModifyFilesystemURI, filesystem.FileContent.StorageResource.ID), fsExpandReqParam, nil)
 }
 
+// ModifyFilesystem - Modify the description, FAST VP tiering policy and snapshot schedule of the filesystem.
+// Fields left empty in the params are not modified.
+func (c *UnityClientImpl) ModifyFilesystem(ctx context.Context, filesystemID string, params types.FsModifyParameters) (err error) {
+	ctx, span := c.startOperation(ctx, "ModifyFilesystem", attributeResourceID.String(filesystemID))
+	defer func() { endOperation(span, err) }()
+	if filesystemID == "" {
+		return errors.New("Filesystem Id cannot be empty")
+	}
+	filesystem, err := c.FindFilesystemByID(ctx, filesystemID)
+	if err != nil {
+		return err
+	}
+	resourceID := filesystem.FileContent.StorageResource.ID
+	err = c.executeWithRetryAuthenticate(ctx, http.MethodPost, fmt.Sprintf(api.UnityModifyFilesystemURI, resourceID), params, nil)
+	if err != nil {
+		return fmt.Errorf("modify filesystem %s failed. Error: %v", filesystemID, err)
+	}
+	return nil
+}
+
 func (c *UnityClientImpl) GetAllNFSServers(ctx context.Context) (*types.NFSServersResponse, error) {
 	log := util.GetRunIDLogger(ctx)
 
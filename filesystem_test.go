@@ -199,6 +199,11 @@ func TestFindNfsShare(t *testing.T) {
 	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", anyArgs...).Return(errors.New("find nfs share failed")).Once()
 	_, err = testConf.client.FindNFSShareByName(ctx, nfsShareNameTemp)
 	assert.Error(t, err)
+	assert.NotErrorIs(t, err, ErrorNFSShareNotFound)
+
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", anyArgs...).Return(fmt.Errorf("error code %s", NFSShareNotFoundErrorCode)).Once()
+	_, err = testConf.client.FindNFSShareByName(ctx, nfsShareNameTemp)
+	assert.ErrorIs(t, err, ErrorNFSShareNotFound)
 
 	fmt.Println("Find NFS Share Test Successful")
 }
@@ -391,6 +396,50 @@ func TestExpandFilesystem(t *testing.T) {
 	fmt.Println("Expand Filesystem Test Successful")
 }
 
+func TestModifyFilesystem(t *testing.T) {
+	fmt.Println("Begin - Modify Filesystem Test")
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).ExpectedCalls = nil
+	ctx := context.Background()
+
+	params := types.FsModifyParameters{
+		Description:            "fs",
+		FsParameters:           &types.FsFastVPModifyParameters{FastVPParameters: &types.FastVPParameters{TieringPolicy: 1}},
+		SnapScheduleParameters: &types.SnapScheduleParameters{SnapSchedule: &types.SnapScheduleID{ID: "snapSch_1"}},
+	}
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", anyArgs...).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(*types.Filesystem)
+		resp.FileContent.StorageResource.ID = "res_1"
+	}).Once()
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", mock.Anything, "POST", "/api/instances/storageResource/res_1/action/modifyFilesystem", mock.Anything, params, mock.Anything).Return(nil).Once()
+	err := testConf.client.ModifyFilesystem(ctx, "fs_1", params)
+	assert.NoError(t, err)
+
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", anyArgs...).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(*types.StorageResourceParameters)
+		resp.StorageResourceContent.SnapSchedule.ID = "snapSch_1"
+	}).Once()
+	resource, err := testConf.client.FindStorageResourceByID(ctx, "res_1")
+	assert.NoError(t, err)
+	assert.Equal(t, "snapSch_1", resource.StorageResourceContent.SnapSchedule.ID)
+
+	// Negative cases
+	err = testConf.client.ModifyFilesystem(ctx, "", params)
+	assert.Error(t, err)
+	_, err = testConf.client.FindStorageResourceByID(ctx, "")
+	assert.Error(t, err)
+
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", anyArgs...).Return(nil).Once()
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", anyArgs...).Return(errors.New("modify failed")).Once()
+	err = testConf.client.ModifyFilesystem(ctx, "fs_1", params)
+	assert.ErrorContains(t, err, "modify failed")
+
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", anyArgs...).Return(errors.New("not found")).Once()
+	_, err = testConf.client.FindStorageResourceByID(ctx, "res_1")
+	assert.Error(t, err)
+
+	fmt.Println("Modify Filesystem Test - Successful")
+}
+
 func TestDeleteFilesystem(t *testing.T) {
 	fmt.Println("Begin - Delete Filesystem Test")
 	ctx := context.Background()
@@ -0,0 +1,346 @@
+/*
+ Copyright © 2025 Dell Inc. or its subsidiaries. All Rights Reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package gounity
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/dell/gounity/api"
+	util "github.com/dell/gounity/gounityutil"
+	"gopkg.in/yaml.v3"
+)
+
+// ErrorArrayNotFound is returned when the fleet has no array with the requested ID
+var ErrorArrayNotFound = errors.New("array not found in fleet")
+
+// ArrayConfig holds the connection details of an array of a fleet
+type ArrayConfig struct {
+	ArrayID   string `yaml:"arrayId" json:"arrayId"`
+	Endpoint  string `yaml:"endpoint" json:"endpoint"`
+	Username  string `yaml:"username" json:"username"`
+	Password  string `yaml:"password" json:"password"`
+	Insecure  bool   `yaml:"insecure" json:"insecure"`
+	IsDefault bool   `yaml:"isDefault" json:"isDefault"`
+	// CACertFile, PinnedFingerprints and ServerName are passed to the HTTP client options
+	CACertFile         string   `yaml:"caCertFile" json:"caCertFile"`
+	PinnedFingerprints []string `yaml:"pinnedFingerprints" json:"pinnedFingerprints"`
+	ServerName         string   `yaml:"serverName" json:"serverName"`
+	// CredentialProvider overrides Username and Password if set
+	CredentialProvider CredentialProvider `yaml:"-" json:"-"`
+}
+
+// fleetConfig is the layout of an array definitions file
+type fleetConfig struct {
+	Arrays []ArrayConfig `yaml:"arrays"`
+}
+
+// LoadArrayConfigs reads array definitions in YAML or JSON from r.
+//
+//	arrays:
+//	  - arrayId: "APM00000000001"
+//	    endpoint: "https://10.0.0.1"
+//	    username: "admin"
+//	    password: "password"
+//	    insecure: true
+//	    isDefault: true
+//	  - arrayId: "APM00000000002"
+//	    endpoint: "https://10.0.0.2"
+//	    username: "admin"
+//	    password: "password"
+//	    caCertFile: "/etc/unity/ca.pem"
+//	    serverName: "unity2.example.com"
+func LoadArrayConfigs(r io.Reader) ([]ArrayConfig, error) {
+	config := fleetConfig{}
+	if err := yaml.NewDecoder(r).Decode(&config); err != nil {
+		return nil, fmt.Errorf("unable to parse array definitions: %v", err)
+	}
+	return config.Arrays, nil
+}
+
+// LoadArrayConfigsFromFile reads array definitions in YAML or JSON from the given file
+func LoadArrayConfigsFromFile(path string) ([]ArrayConfig, error) {
+	file, err := os.Open(path) // #nosec G304
+	if err != nil {
+		return nil, fmt.Errorf("unable to open array definitions file %s: %v", path, err)
+	}
+	defer file.Close()
+	return LoadArrayConfigs(file)
+}
+
+// ArrayStatus holds the reachability of an array of a fleet as seen by the last check
+type ArrayStatus struct {
+	ArrayID       string
+	Endpoint      string
+	IsDefault     bool
+	Authenticated bool
+	Reachable     bool
+	LastChecked   time.Time
+	LastError     error
+}
+
+// fleetArray holds the lazily created client of an array
+type fleetArray struct {
+	mutex  sync.Mutex
+	config ArrayConfig
+	client UnityClient
+	status ArrayStatus
+}
+
+// Fleet manages the clients of several arrays, routing calls by array ID.
+// Clients are created and authenticated on first use.
+type Fleet struct {
+	arrays    map[string]*fleetArray
+	arrayIDs  []string
+	defaultID string
+	newClient func(ctx context.Context, endpoint string, opts api.ClientOptions) (UnityClient, error)
+}
+
+// NewFleet returns a fleet of the given arrays. Array IDs must be unique and at most one array may be the default.
+// If a single array is given, it is the default.
+func NewFleet(arrays []ArrayConfig) (*Fleet, error) {
+	if len(arrays) == 0 {
+		return nil, errors.New("at least one array is required")
+	}
+	fleet := &Fleet{arrays: make(map[string]*fleetArray, len(arrays)), newClient: NewClientWithClientOptions}
+	for _, array := range arrays {
+		if array.ArrayID == "" {
+			return nil, errors.New("array ID cannot be empty")
+		}
+		if array.Endpoint == "" {
+			return nil, fmt.Errorf("endpoint of array %s cannot be empty", array.ArrayID)
+		}
+		if _, ok := fleet.arrays[array.ArrayID]; ok {
+			return nil, fmt.Errorf("duplicate array ID %s", array.ArrayID)
+		}
+		if array.IsDefault {
+			if fleet.defaultID != "" {
+				return nil, fmt.Errorf("arrays %s and %s are both marked as default", fleet.defaultID, array.ArrayID)
+			}
+			fleet.defaultID = array.ArrayID
+		}
+		fleet.arrays[array.ArrayID] = &fleetArray{
+			config: array,
+			status: ArrayStatus{ArrayID: array.ArrayID, Endpoint: array.Endpoint, IsDefault: array.IsDefault},
+		}
+		fleet.arrayIDs = append(fleet.arrayIDs, array.ArrayID)
+	}
+	if len(arrays) == 1 {
+		fleet.defaultID = arrays[0].ArrayID
+	}
+	return fleet, nil
+}
+
+// ArrayIDs returns the IDs of the arrays in the order they were defined
+func (f *Fleet) ArrayIDs() []string {
+	return append([]string(nil), f.arrayIDs...)
+}
+
+// DefaultArrayID returns the ID of the default array or an empty string if there is none
+func (f *Fleet) DefaultArrayID() string {
+	return f.defaultID
+}
+
+// array returns the array with the given ID, or the default array if arrayID is empty
+func (f *Fleet) array(arrayID string) (*fleetArray, error) {
+	if arrayID == "" {
+		if f.defaultID == "" {
+			return nil, errors.New("no array ID given and the fleet has no default array")
+		}
+		arrayID = f.defaultID
+	}
+	array, ok := f.arrays[arrayID]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrorArrayNotFound, arrayID)
+	}
+	return array, nil
+}
+
+// configConnect returns the connection details of the array
+func (a *fleetArray) configConnect() *ConfigConnect {
+	return &ConfigConnect{
+		Endpoint:           a.config.Endpoint,
+		Username:           a.config.Username,
+		Password:           a.config.Password,
+		Insecure:           a.config.Insecure,
+		CredentialProvider: a.config.CredentialProvider,
+	}
+}
+
+// clientOptions returns the HTTP client options of the array
+func (a *fleetArray) clientOptions() api.ClientOptions {
+	return api.ClientOptions{
+		Insecure:               a.config.Insecure,
+		CACertificatesFile:     a.config.CACertFile,
+		PinnedSPKIFingerprints: a.config.PinnedFingerprints,
+		ServerName:             a.config.ServerName,
+	}
+}
+
+// getClient creates the client of the array if needed. The caller must hold the array mutex.
+func (f *Fleet) getClient(ctx context.Context, array *fleetArray) (UnityClient, error) {
+	if array.client != nil {
+		return array.client, nil
+	}
+	client, err := f.newClient(ctx, array.config.Endpoint, array.clientOptions())
+	if err != nil {
+		return nil, fmt.Errorf("unable to create client of array %s: %v", array.config.ArrayID, err)
+	}
+	array.client = client
+	return client, nil
+}
+
+// Client returns the authenticated client of the array with the given ID, or of the default array if arrayID is empty.
+// The client is created and authenticated on first use; a failed authentication is retried on the next call.
+func (f *Fleet) Client(ctx context.Context, arrayID string) (UnityClient, error) {
+	log := util.GetRunIDLogger(ctx)
+	array, err := f.array(arrayID)
+	if err != nil {
+		return nil, err
+	}
+
+	array.mutex.Lock()
+	defer array.mutex.Unlock()
+	client, err := f.getClient(ctx, array)
+	if err != nil {
+		return nil, err
+	}
+	if array.status.Authenticated {
+		return client, nil
+	}
+
+	configConnect := array.configConnect()
+	if err := client.BasicSystemInfo(ctx, configConnect); err != nil {
+		array.setReachability(err)
+		return nil, fmt.Errorf("array %s is not reachable: %v", array.config.ArrayID, err)
+	}
+	array.setReachability(nil)
+	if err := client.Authenticate(ctx, configConnect); err != nil {
+		return nil, fmt.Errorf("unable to authenticate to array %s: %v", array.config.ArrayID, err)
+	}
+	array.status.Authenticated = true
+	log.Debugf("Authenticated to array %s", array.config.ArrayID)
+	return client, nil
+}
+
+// setReachability records the result of a reachability check. The caller must hold the array mutex.
+func (a *fleetArray) setReachability(err error) {
+	a.status.Reachable = err == nil
+	a.status.LastError = err
+	a.status.LastChecked = time.Now()
+}
+
+// Status returns the status of every array in the order they were defined
+func (f *Fleet) Status() []ArrayStatus {
+	statuses := make([]ArrayStatus, 0, len(f.arrayIDs))
+	for _, id := range f.arrayIDs {
+		array := f.arrays[id]
+		array.mutex.Lock()
+		statuses = append(statuses, array.status)
+		array.mutex.Unlock()
+	}
+	return statuses
+}
+
+// CheckReachability calls BasicSystemInfo on every array concurrently and records whether it responded.
+// BasicSystemInfo does not need a session, so unauthenticated arrays are checked too.
+func (f *Fleet) CheckReachability(ctx context.Context) []ArrayStatus {
+	log := util.GetRunIDLogger(ctx)
+	var wg sync.WaitGroup
+	for _, id := range f.arrayIDs {
+		array := f.arrays[id]
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			// the mutex is not held during the call so that a stalled array does not block its other users
+			array.mutex.Lock()
+			client, err := f.getClient(ctx, array)
+			array.mutex.Unlock()
+			if err == nil {
+				err = client.BasicSystemInfo(ctx, array.configConnect())
+			}
+			if err != nil {
+				log.Warnf("Array %s is not reachable: %v", array.config.ArrayID, err)
+			}
+			array.mutex.Lock()
+			array.setReachability(err)
+			array.mutex.Unlock()
+		}()
+	}
+	wg.Wait()
+	return f.Status()
+}
+
+// StartHealthChecks checks the reachability of every array at the given interval until ctx is cancelled
+func (f *Fleet) StartHealthChecks(ctx context.Context, interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			f.CheckReachability(ctx)
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// FanOutResult holds the results of a fan-out call keyed by array ID
+type FanOutResult[T any] struct {
+	Results map[string]T
+	Errors  map[string]error
+}
+
+// FanOut calls fn with the client of every array of the fleet concurrently and merges the results.
+// Arrays whose client cannot be obtained or whose call fails are reported in Errors.
+func FanOut[T any](ctx context.Context, fleet *Fleet, fn func(ctx context.Context, arrayID string, client UnityClient) (T, error)) *FanOutResult[T] {
+	result := &FanOutResult[T]{Results: map[string]T{}, Errors: map[string]error{}}
+	var mutex sync.Mutex
+	var wg sync.WaitGroup
+	for _, id := range fleet.arrayIDs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var value T
+			client, err := fleet.Client(ctx, id)
+			if err == nil {
+				value, err = fn(ctx, id, client)
+			}
+			mutex.Lock()
+			defer mutex.Unlock()
+			if err != nil {
+				result.Errors[id] = err
+				return
+			}
+			result.Results[id] = value
+		}()
+	}
+	wg.Wait()
+	return result
+}
+
+// ForEach calls fn with the client of every array of the fleet concurrently and returns the errors keyed by array ID
+func (f *Fleet) ForEach(ctx context.Context, fn func(ctx context.Context, arrayID string, client UnityClient) error) map[string]error {
+	return FanOut(ctx, f, func(ctx context.Context, arrayID string, client UnityClient) (struct{}, error) {
+		return struct{}{}, fn(ctx, arrayID, client)
+	}).Errors
+}
@@ -0,0 +1,185 @@
+/*
+ Copyright © 2025 Dell Inc. or its subsidiaries. All Rights Reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package gounity
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/dell/gounity/api"
+	types "github.com/dell/gounity/apitypes"
+	mocksapi "github.com/dell/gounity/mocks/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const fleetConfigYAML = `
+arrays:
+  - arrayId: "array-1"
+    endpoint: "https://array-1"
+    username: "admin"
+    password: "password"
+    insecure: true
+    isDefault: true
+  - arrayId: "array-2"
+    endpoint: "https://array-2"
+    username: "admin"
+    password: "password"
+`
+
+func newOKResponse() *http.Response {
+	return &http.Response{StatusCode: http.StatusOK, Header: http.Header{}, Body: io.NopCloser(strings.NewReader("{}"))}
+}
+
+// newTestFleet returns a fleet whose array-2 is unreachable
+func newTestFleet(t *testing.T) (*Fleet, map[string]*mocksapi.Client) {
+	arrays, err := LoadArrayConfigs(strings.NewReader(fleetConfigYAML))
+	require.NoError(t, err)
+	fleet, err := NewFleet(arrays)
+	require.NoError(t, err)
+
+	var mutex sync.Mutex
+	apiClients := map[string]*mocksapi.Client{}
+	fleet.newClient = func(_ context.Context, endpoint string, _ api.ClientOptions) (UnityClient, error) {
+		apiClient := &mocksapi.Client{}
+		if endpoint == "https://array-2" {
+			apiClient.On("DoAndGetResponseBody", anyArgs[:5]...).Return(nil, errors.New("connection refused"))
+		} else {
+			apiClient.On("DoAndGetResponseBody", anyArgs[:5]...).Return(newOKResponse(), nil)
+			apiClient.On("SetToken", mock.Anything).Return()
+			apiClient.On("DoWithHeaders", anyArgs...).Return(nil).Run(func(args mock.Arguments) {
+				resp := args.Get(5).(*types.ListStoragePools)
+				resp.StoragePools = []types.StoragePool{{StoragePoolContent: types.StoragePoolContent{ID: "pool_1"}}}
+			})
+		}
+		mutex.Lock()
+		apiClients[endpoint] = apiClient
+		mutex.Unlock()
+		return &UnityClientImpl{api: apiClient, configConnect: &ConfigConnect{}}, nil
+	}
+	return fleet, apiClients
+}
+
+func TestNewFleet(t *testing.T) {
+	arrays, err := LoadArrayConfigs(strings.NewReader(fleetConfigYAML))
+	assert.NoError(t, err)
+	assert.Len(t, arrays, 2)
+	assert.True(t, arrays[0].Insecure)
+
+	fleet, err := NewFleet(arrays)
+	assert.NoError(t, err)
+	assert.Equal(t, "array-1", fleet.DefaultArrayID())
+	assert.Equal(t, []string{"array-1", "array-2"}, fleet.ArrayIDs())
+
+	// A single array is the default
+	fleet, err = NewFleet(arrays[1:])
+	assert.NoError(t, err)
+	assert.Equal(t, "array-2", fleet.DefaultArrayID())
+
+	// Negative cases
+	_, err = NewFleet(nil)
+	assert.Error(t, err)
+	_, err = NewFleet([]ArrayConfig{arrays[0], arrays[0]})
+	assert.Error(t, err)
+	_, err = NewFleet([]ArrayConfig{arrays[0], {ArrayID: "array-3", Endpoint: "https://array-3", IsDefault: true}})
+	assert.Error(t, err)
+	_, err = NewFleet([]ArrayConfig{{ArrayID: "array-3"}})
+	assert.Error(t, err)
+	_, err = LoadArrayConfigs(strings.NewReader("arrays: ["))
+	assert.Error(t, err)
+	_, err = LoadArrayConfigsFromFile("/nonexistent/arrays.yaml")
+	assert.Error(t, err)
+}
+
+func TestFleetClient(t *testing.T) {
+	ctx := context.Background()
+	fleet, apiClients := newTestFleet(t)
+
+	client, err := fleet.Client(ctx, "")
+	assert.NoError(t, err)
+	assert.NotNil(t, client)
+
+	// The client is authenticated once
+	_, err = fleet.Client(ctx, "array-1")
+	assert.NoError(t, err)
+	apiClients["https://array-1"].AssertNumberOfCalls(t, "DoAndGetResponseBody", 2)
+
+	// Negative cases
+	_, err = fleet.Client(ctx, "array-2")
+	assert.Error(t, err)
+	_, err = fleet.Client(ctx, "array-3")
+	assert.ErrorIs(t, err, ErrorArrayNotFound)
+
+	statuses := fleet.Status()
+	assert.True(t, statuses[0].Authenticated)
+	assert.False(t, statuses[1].Reachable)
+	assert.Error(t, statuses[1].LastError)
+}
+
+func TestFleetCheckReachability(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	fleet, _ := newTestFleet(t)
+
+	statuses := fleet.CheckReachability(ctx)
+	assert.True(t, statuses[0].Reachable)
+	assert.False(t, statuses[0].Authenticated)
+	assert.False(t, statuses[1].Reachable)
+
+	fleet.StartHealthChecks(ctx, time.Millisecond)
+	assert.Eventually(t, func() bool {
+		return fleet.Status()[0].LastChecked.After(statuses[0].LastChecked)
+	}, time.Second, time.Millisecond)
+
+	// A stalled array does not block the status while it is checked
+	fleet, _ = newTestFleet(t)
+	called, release := make(chan struct{}), make(chan struct{})
+	stalled := &mocksapi.Client{}
+	stalled.On("DoAndGetResponseBody", anyArgs[:5]...).Return(newOKResponse(), nil).Run(func(mock.Arguments) {
+		close(called)
+		<-release
+	}).Once()
+	fleet.arrays["array-2"].client = &UnityClientImpl{api: stalled, configConnect: &ConfigConnect{}}
+	done := make(chan []ArrayStatus)
+	go func() { done <- fleet.CheckReachability(context.Background()) }()
+	<-called
+	assert.False(t, fleet.Status()[1].Reachable)
+	close(release)
+	assert.True(t, (<-done)[1].Reachable)
+}
+
+func TestFanOut(t *testing.T) {
+	ctx := context.Background()
+	fleet, _ := newTestFleet(t)
+
+	result := FanOut(ctx, fleet, func(ctx context.Context, _ string, client UnityClient) ([]types.StoragePool, error) {
+		return client.ListStoragePools(ctx)
+	})
+	assert.Len(t, result.Results, 1)
+	assert.Len(t, result.Results["array-1"], 1)
+	assert.Error(t, result.Errors["array-2"])
+
+	errs := fleet.ForEach(ctx, func(_ context.Context, arrayID string, _ UnityClient) error {
+		return errors.New("failed on " + arrayID)
+	})
+	assert.Len(t, errs, 2)
+}
@@ -0,0 +1,366 @@
+/*
+ Copyright © 2025 Dell Inc. or its subsidiaries. All Rights Reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package gounity
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	types "github.com/dell/gounity/apitypes"
+	util "github.com/dell/gounity/gounityutil"
+)
+
+// SystemCapacityResourceID is the resource ID under which system capacity samples are stored
+const SystemCapacityResourceID = "system"
+
+// ForecastFullConfidenceSamples is the number of samples from which the confidence of a forecast
+// only depends on how well the samples fit the growth trend
+const ForecastFullConfidenceSamples = 14
+
+// ForecastHorizonDays is the number of days beyond which a forecast does not project an exhaustion, as a near flat
+// growth trend would project dates beyond what time.Time and time.Duration hold
+const ForecastHorizonDays = 100 * 365
+
+// PoolCapacityMetricPaths holds the historical metric paths of the pool capacity attributes
+// used by ImportPoolMetricValues. The values are expected in bytes.
+var PoolCapacityMetricPaths = struct {
+	SizeUsed       string
+	SizeSubscribed string
+	SizeFree       string
+}{
+	SizeUsed:       "sp.*.storage.pool.*.sizeUsed",
+	SizeSubscribed: "sp.*.storage.pool.*.sizeSubscribed",
+	SizeFree:       "sp.*.storage.pool.*.sizeFree",
+}
+
+// ErrorInsufficientSamples is returned when there are not enough samples to fit a growth trend
+var ErrorInsufficientSamples = errors.New("at least two capacity samples at different times are required")
+
+// CapacitySample holds the capacity of a pool or of the system at a point in time
+type CapacitySample struct {
+	Timestamp      time.Time
+	SizeUsed       uint64
+	SizeSubscribed uint64
+	SizeFree       uint64
+}
+
+// CapacitySampleStore stores capacity samples per resource ID
+type CapacitySampleStore interface {
+	// AddSample stores the sample. A sample with the same timestamp replaces the stored one.
+	AddSample(ctx context.Context, resourceID string, sample CapacitySample) error
+	// Samples returns the samples of the resource ordered by timestamp
+	Samples(ctx context.Context, resourceID string) ([]CapacitySample, error)
+	// ResourceIDs returns the IDs of the resources having samples
+	ResourceIDs(ctx context.Context) ([]string, error)
+}
+
+// MemoryCapacitySampleStore is a CapacitySampleStore keeping the samples in memory
+type MemoryCapacitySampleStore struct {
+	mutex      sync.Mutex
+	maxSamples int
+	samples    map[string][]CapacitySample
+}
+
+// NewMemoryCapacitySampleStore returns an in-memory store keeping at most maxSamples samples per resource.
+// The oldest samples are dropped first. A maxSamples of zero or less keeps every sample.
+func NewMemoryCapacitySampleStore(maxSamples int) *MemoryCapacitySampleStore {
+	return &MemoryCapacitySampleStore{maxSamples: maxSamples, samples: map[string][]CapacitySample{}}
+}
+
+// AddSample stores the sample of the resource
+func (s *MemoryCapacitySampleStore) AddSample(_ context.Context, resourceID string, sample CapacitySample) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	samples := s.samples[resourceID]
+	i := sort.Search(len(samples), func(i int) bool { return !samples[i].Timestamp.Before(sample.Timestamp) })
+	if i < len(samples) && samples[i].Timestamp.Equal(sample.Timestamp) {
+		samples[i] = sample
+	} else {
+		samples = append(samples, CapacitySample{})
+		copy(samples[i+1:], samples[i:])
+		samples[i] = sample
+	}
+	if s.maxSamples > 0 && len(samples) > s.maxSamples {
+		samples = samples[len(samples)-s.maxSamples:]
+	}
+	s.samples[resourceID] = samples
+	return nil
+}
+
+// Samples returns a copy of the samples of the resource
+func (s *MemoryCapacitySampleStore) Samples(_ context.Context, resourceID string) ([]CapacitySample, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return append([]CapacitySample(nil), s.samples[resourceID]...), nil
+}
+
+// ResourceIDs returns the sorted IDs of the resources having samples
+func (s *MemoryCapacitySampleStore) ResourceIDs(_ context.Context) ([]string, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	ids := make([]string, 0, len(s.samples))
+	for id := range s.samples {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids, nil
+}
+
+// CapacityForecast holds the growth trend of the used capacity of a resource and its projected exhaustion.
+// ExhaustionDate and DaysToExhaustion are zero if the used capacity is not growing, or grows too slowly to
+// reach the total capacity within ForecastHorizonDays. Use Exhausts to tell these from an exhausted resource.
+// Confidence ranges from 0 to 1.
+type CapacityForecast struct {
+	ResourceID       string
+	Samples          int
+	SizeUsed         uint64
+	SizeTotal        uint64
+	GrowthPerDay     float64
+	DaysToExhaustion float64
+	ExhaustionDate   time.Time
+	Confidence       float64
+}
+
+// Exhausts returns true if the used capacity is projected to reach the total capacity
+func (f *CapacityForecast) Exhausts() bool {
+	return !f.ExhaustionDate.IsZero()
+}
+
+// ForecastExhaustion fits a linear growth trend to the used capacity of the samples by least squares
+// and projects when it reaches the total capacity (used plus free) of the latest sample.
+// The confidence is the coefficient of determination of the fit, reduced when there are fewer than
+// ForecastFullConfidenceSamples samples.
+func ForecastExhaustion(resourceID string, samples []CapacitySample) (*CapacityForecast, error) {
+	if len(samples) < 2 {
+		return nil, ErrorInsufficientSamples
+	}
+	sorted := append([]CapacitySample(nil), samples...)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Timestamp.Before(sorted[j].Timestamp) })
+	first, last := sorted[0], sorted[len(sorted)-1]
+	if !last.Timestamp.After(first.Timestamp) {
+		return nil, ErrorInsufficientSamples
+	}
+
+	// Least squares fit of used capacity against days since the first sample
+	n := float64(len(sorted))
+	var sumX, sumY float64
+	xs := make([]float64, len(sorted))
+	for i, sample := range sorted {
+		xs[i] = sample.Timestamp.Sub(first.Timestamp).Hours() / 24
+		sumX += xs[i]
+		sumY += float64(sample.SizeUsed)
+	}
+	meanX, meanY := sumX/n, sumY/n
+	var sxx, sxy, syy float64
+	for i, sample := range sorted {
+		dx, dy := xs[i]-meanX, float64(sample.SizeUsed)-meanY
+		sxx += dx * dx
+		sxy += dx * dy
+		syy += dy * dy
+	}
+	slope := sxy / sxx
+	intercept := meanY - slope*meanX
+
+	r2 := 1.0
+	if syy > 0 {
+		r2 = sxy * sxy / (sxx * syy)
+	}
+
+	forecast := &CapacityForecast{
+		ResourceID:   resourceID,
+		Samples:      len(sorted),
+		SizeUsed:     last.SizeUsed,
+		SizeTotal:    last.SizeUsed + last.SizeFree,
+		GrowthPerDay: slope,
+		Confidence:   r2 * math.Min(1, n/ForecastFullConfidenceSamples),
+	}
+	if slope <= 0 {
+		return forecast, nil
+	}
+
+	// Project from the trend value at the latest sample so that a single outlier does not shift the date
+	lastX := xs[len(xs)-1]
+	days := (float64(forecast.SizeTotal) - (intercept + slope*lastX)) / slope
+	if days < 0 {
+		days = 0
+	}
+	if days > ForecastHorizonDays {
+		return forecast, nil
+	}
+	forecast.DaysToExhaustion = days
+	forecast.ExhaustionDate = last.Timestamp.Add(time.Duration(days * 24 * float64(time.Hour)))
+	return forecast, nil
+}
+
+// CapacityForecaster records capacity samples of the pools and of the system into a store and forecasts their exhaustion
+type CapacityForecaster struct {
+	client UnityClient
+	store  CapacitySampleStore
+	now    func() time.Time
+}
+
+// NewCapacityForecaster returns a forecaster recording the samples of the given client into the store
+func NewCapacityForecaster(client UnityClient, store CapacitySampleStore) *CapacityForecaster {
+	return &CapacityForecaster{client: client, store: store, now: time.Now}
+}
+
+// RecordPoolSample records the current capacity of the pool
+func (f *CapacityForecaster) RecordPoolSample(ctx context.Context, poolID string) error {
+	// samples must reflect the array, not the pool cache
+	pool, err := f.client.FindStoragePoolByID(WithNoCache(ctx), poolID)
+	if err != nil {
+		return err
+	}
+	sample := CapacitySample{
+		Timestamp:      f.now(),
+		SizeUsed:       pool.StoragePoolContent.UsedCapacity,
+		SizeSubscribed: pool.StoragePoolContent.SubscribedCapacity,
+		SizeFree:       pool.StoragePoolContent.FreeCapacity,
+	}
+	return f.store.AddSample(ctx, poolID, sample)
+}
+
+// RecordSystemSample records the current capacity of the system under SystemCapacityResourceID
+func (f *CapacityForecaster) RecordSystemSample(ctx context.Context) error {
+	capacity, err := f.client.GetCapacity(ctx)
+	if err != nil {
+		return err
+	}
+	if len(capacity.Entries) == 0 {
+		return errors.New("system capacity not found")
+	}
+	content := capacity.Entries[0].Content
+	sample := CapacitySample{
+		Timestamp:      f.now(),
+		SizeUsed:       uint64(content.SizeUsed),
+		SizeSubscribed: uint64(content.SizeSubscribed),
+		SizeFree:       uint64(content.SizeFree),
+	}
+	return f.store.AddSample(ctx, SystemCapacityResourceID, sample)
+}
+
+// ImportPoolMetricValues records the samples of the pool found in the historical metric values of
+// PoolCapacityMetricPaths and returns the number of samples recorded
+func (f *CapacityForecaster) ImportPoolMetricValues(ctx context.Context, poolID string) (int, error) {
+	log := util.GetRunIDLogger(ctx)
+	samples := map[time.Time]*CapacitySample{}
+	// bit i is set once the value of attributes[i] is found for the timestamp
+	found := map[time.Time]uint{}
+	attributes := []struct {
+		path  string
+		value func(*CapacitySample) *uint64
+	}{
+		{PoolCapacityMetricPaths.SizeUsed, func(s *CapacitySample) *uint64 { return &s.SizeUsed }},
+		{PoolCapacityMetricPaths.SizeSubscribed, func(s *CapacitySample) *uint64 { return &s.SizeSubscribed }},
+		{PoolCapacityMetricPaths.SizeFree, func(s *CapacitySample) *uint64 { return &s.SizeFree }},
+	}
+	for i, attribute := range attributes {
+		values, err := f.client.ListMetricValues(ctx, attribute.path)
+		if err != nil {
+			return 0, err
+		}
+		for _, value := range values {
+			size, ok := metricValueOf(value, poolID)
+			if !ok {
+				continue
+			}
+			sample, ok := samples[value.Timestamp]
+			if !ok {
+				sample = &CapacitySample{Timestamp: value.Timestamp}
+				samples[value.Timestamp] = sample
+			}
+			*attribute.value(sample) = size
+			found[value.Timestamp] |= 1 << i
+		}
+	}
+
+	// a sample missing an attribute would be read as an empty or full pool
+	complete := uint(1)<<len(attributes) - 1
+	recorded := 0
+	for timestamp, sample := range samples {
+		if found[timestamp] != complete {
+			continue
+		}
+		if err := f.store.AddSample(ctx, poolID, *sample); err != nil {
+			return 0, err
+		}
+		recorded++
+	}
+	log.Debugf("ImportPoolMetricValues: recorded %d of %d samples of pool %s", recorded, len(samples), poolID)
+	return recorded, nil
+}
+
+// metricValueOf returns the value of the object with the given ID from metric values keyed by SP and object ID.
+// Pool metrics are reported by one SP only.
+func metricValueOf(value types.MetricValue, objectID string) (uint64, bool) {
+	for _, spValues := range value.Values {
+		objectValues, ok := spValues.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		switch v := objectValues[objectID].(type) {
+		case float64:
+			return uint64(v), true
+		case string:
+			size, err := strconv.ParseFloat(v, 64)
+			if err == nil {
+				return uint64(size), true
+			}
+		}
+	}
+	return 0, false
+}
+
+// Forecast forecasts the exhaustion of the resource from its recorded samples
+func (f *CapacityForecaster) Forecast(ctx context.Context, resourceID string) (*CapacityForecast, error) {
+	samples, err := f.store.Samples(ctx, resourceID)
+	if err != nil {
+		return nil, err
+	}
+	forecast, err := ForecastExhaustion(resourceID, samples)
+	if err != nil {
+		return nil, fmt.Errorf("unable to forecast capacity of %s: %w", resourceID, err)
+	}
+	return forecast, nil
+}
+
+// ForecastAll forecasts the exhaustion of every resource in the store.
+// Resources without enough samples are skipped.
+func (f *CapacityForecaster) ForecastAll(ctx context.Context) ([]CapacityForecast, error) {
+	log := util.GetRunIDLogger(ctx)
+	ids, err := f.store.ResourceIDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	forecasts := []CapacityForecast{}
+	for _, id := range ids {
+		forecast, err := f.Forecast(ctx, id)
+		if errors.Is(err, ErrorInsufficientSamples) {
+			log.Debugf("ForecastAll: skipping %s: %v", id, err)
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		forecasts = append(forecasts, *forecast)
+	}
+	return forecasts, nil
+}
@@ -0,0 +1,220 @@
+/*
+ Copyright © 2025 Dell Inc. or its subsidiaries. All Rights Reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package gounity
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/url"
+	"testing"
+	"time"
+
+	types "github.com/dell/gounity/apitypes"
+	mocksapi "github.com/dell/gounity/mocks/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var forecastStart = time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// syntheticSamples returns daily samples of a pool of the given total size growing by growth per day
+func syntheticSamples(days int, total, used, growth uint64, noise func(day int) int64) []CapacitySample {
+	samples := make([]CapacitySample, 0, days)
+	for day := 0; day < days; day++ {
+		u := used + uint64(day)*growth
+		if noise != nil {
+			u = uint64(int64(u) + noise(day))
+		}
+		samples = append(samples, CapacitySample{Timestamp: forecastStart.AddDate(0, 0, day), SizeUsed: u, SizeFree: total - u})
+	}
+	return samples
+}
+
+func TestForecastExhaustion(t *testing.T) {
+	// Linear growth of 10 GiB a day, 500 GiB free after 30 days
+	samples := syntheticSamples(30, 1000*gib, 210*gib, 10*gib, nil)
+	forecast, err := ForecastExhaustion("pool_1", samples)
+	require.NoError(t, err)
+	assert.InDelta(t, float64(10*gib), forecast.GrowthPerDay, 1)
+	assert.InDelta(t, 50, forecast.DaysToExhaustion, 0.001)
+	assert.True(t, forecast.Exhausts())
+	assert.Equal(t, forecastStart.AddDate(0, 0, 79), forecast.ExhaustionDate.Round(time.Second))
+	assert.InDelta(t, 1, forecast.Confidence, 0.001)
+
+	// Noisy growth lowers the confidence
+	noisy := syntheticSamples(30, 1000*gib, 210*gib, 10*gib, func(day int) int64 {
+		if day%2 == 0 {
+			return int64(40 * gib)
+		}
+		return -int64(40 * gib)
+	})
+	forecast, err = ForecastExhaustion("pool_1", noisy)
+	require.NoError(t, err)
+	assert.Less(t, forecast.Confidence, 0.9)
+	assert.InDelta(t, 50, forecast.DaysToExhaustion, 5)
+
+	// Few samples lower the confidence
+	forecast, err = ForecastExhaustion("pool_1", samples[:7])
+	require.NoError(t, err)
+	assert.InDelta(t, 0.5, forecast.Confidence, 0.001)
+
+	// Shrinking usage never exhausts
+	shrinking := []CapacitySample{
+		{Timestamp: forecastStart, SizeUsed: 500 * gib, SizeFree: 500 * gib},
+		{Timestamp: forecastStart.AddDate(0, 0, 1), SizeUsed: 400 * gib, SizeFree: 600 * gib},
+	}
+	forecast, err = ForecastExhaustion("pool_1", shrinking)
+	require.NoError(t, err)
+	assert.False(t, forecast.Exhausts())
+	assert.Zero(t, forecast.DaysToExhaustion)
+
+	// A forecast that does not exhaust can be marshaled
+	data, err := json.Marshal(forecast)
+	require.NoError(t, err)
+	var unmarshaled CapacityForecast
+	require.NoError(t, json.Unmarshal(data, &unmarshaled))
+	assert.Equal(t, *forecast, unmarshaled)
+
+	// Near flat growth would exhaust beyond the horizon
+	flat := []CapacitySample{
+		{Timestamp: forecastStart, SizeUsed: 500 * gib, SizeFree: 500 * gib},
+		{Timestamp: forecastStart.AddDate(0, 0, 30), SizeUsed: 500*gib + 1, SizeFree: 500*gib - 1},
+	}
+	forecast, err = ForecastExhaustion("pool_1", flat)
+	require.NoError(t, err)
+	assert.Greater(t, forecast.GrowthPerDay, 0.0)
+	assert.False(t, forecast.Exhausts())
+	assert.Zero(t, forecast.DaysToExhaustion)
+
+	// Negative cases
+	_, err = ForecastExhaustion("pool_1", samples[:1])
+	assert.ErrorIs(t, err, ErrorInsufficientSamples)
+	_, err = ForecastExhaustion("pool_1", []CapacitySample{samples[0], samples[0]})
+	assert.ErrorIs(t, err, ErrorInsufficientSamples)
+}
+
+func TestMemoryCapacitySampleStore(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryCapacitySampleStore(3)
+	for _, day := range []int{3, 1, 2, 0} {
+		assert.NoError(t, store.AddSample(ctx, "pool_1", CapacitySample{Timestamp: forecastStart.AddDate(0, 0, day), SizeUsed: uint64(day)}))
+	}
+	assert.NoError(t, store.AddSample(ctx, "pool_1", CapacitySample{Timestamp: forecastStart.AddDate(0, 0, 2), SizeUsed: 20}))
+
+	samples, err := store.Samples(ctx, "pool_1")
+	assert.NoError(t, err)
+	assert.Len(t, samples, 3)
+	assert.Equal(t, []uint64{1, 20, 3}, []uint64{samples[0].SizeUsed, samples[1].SizeUsed, samples[2].SizeUsed})
+
+	ids, err := store.ResourceIDs(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"pool_1"}, ids)
+}
+
+func TestCapacityForecaster(t *testing.T) {
+	ctx := context.Background()
+	apiClient := &mocksapi.Client{}
+	client := &UnityClientImpl{api: apiClient, configConnect: &ConfigConnect{}}
+	store := NewMemoryCapacitySampleStore(0)
+	forecaster := NewCapacityForecaster(client, store)
+	day := 0
+	forecaster.now = func() time.Time { return forecastStart.AddDate(0, 0, day) }
+
+	for day = 0; day < 3; day++ {
+		used := uint64(100+day*10) * gib
+		apiClient.On("DoWithHeaders", mock.Anything, "GET", "/api/instances/pool/pool_1?fields="+StoragePoolFields, mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+			resp := args.Get(5).(*types.StoragePool)
+			resp.StoragePoolContent = types.StoragePoolContent{ID: "pool_1", UsedCapacity: used, FreeCapacity: 1000*gib - used}
+		}).Once()
+		assert.NoError(t, forecaster.RecordPoolSample(ctx, "pool_1"))
+
+		apiClient.On("DoWithHeaders", mock.Anything, "GET", "/api/types/systemCapacity/instances?fields="+SystemCapacityFields, mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+			resp := args.Get(5).(*types.SystemCapacityMetricsQueryResult)
+			resp.Entries = []types.SystemCapacityMetricsResultEntry{{Content: types.SystemCapacityMetricResult{SizeUsed: 1000, SizeFree: 1000}}}
+		}).Once()
+		assert.NoError(t, forecaster.RecordSystemSample(ctx))
+	}
+
+	forecast, err := forecaster.Forecast(ctx, "pool_1")
+	assert.NoError(t, err)
+	assert.InDelta(t, 88, forecast.DaysToExhaustion, 0.001)
+
+	forecasts, err := forecaster.ForecastAll(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, forecasts, 2)
+	assert.False(t, forecasts[1].Exhausts())
+
+	// Negative cases
+	apiClient.On("DoWithHeaders", anyArgs...).Return(errors.New("array unreachable")).Twice()
+	assert.Error(t, forecaster.RecordPoolSample(ctx, "pool_1"))
+	assert.Error(t, forecaster.RecordSystemSample(ctx))
+
+	_, err = forecaster.Forecast(ctx, "pool_2")
+	assert.ErrorIs(t, err, ErrorInsufficientSamples)
+}
+
+func TestImportPoolMetricValues(t *testing.T) {
+	ctx := context.Background()
+	apiClient := &mocksapi.Client{}
+	client := &UnityClientImpl{api: apiClient, configConnect: &ConfigConnect{}}
+	store := NewMemoryCapacitySampleStore(0)
+	forecaster := NewCapacityForecaster(client, store)
+
+	mockMetricValues := func(path string, values ...interface{}) {
+		apiClient.On("DoWithHeaders", mock.Anything, "GET", mock.MatchedBy(func(uri string) bool {
+			return uri == "/api/types/metricValue/instances?filter="+url.QueryEscape(`path EQ "`+path+`"`)+"&per_page=2000&page=1"
+		}), mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+			resp := args.Get(5).(*types.ListMetricValues)
+			for i, value := range values {
+				if value == nil {
+					continue
+				}
+				resp.Entries = append(resp.Entries, types.MetricValueEntry{Content: types.MetricValue{
+					Path:      path,
+					Timestamp: forecastStart.AddDate(0, 0, i),
+					Values:    map[string]interface{}{"spa": map[string]interface{}{"pool_1": value, "pool_2": float64(1)}},
+				}})
+			}
+		}).Once()
+	}
+	mockMetricValues(PoolCapacityMetricPaths.SizeUsed, float64(100), "110")
+	mockMetricValues(PoolCapacityMetricPaths.SizeSubscribed, float64(300), float64(310))
+	mockMetricValues(PoolCapacityMetricPaths.SizeFree, float64(900), float64(890))
+
+	count, err := forecaster.ImportPoolMetricValues(ctx, "pool_1")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, count)
+	samples, _ := store.Samples(ctx, "pool_1")
+	assert.Equal(t, CapacitySample{Timestamp: forecastStart.AddDate(0, 0, 1), SizeUsed: 110, SizeSubscribed: 310, SizeFree: 890}, samples[1])
+
+	// Samples missing an attribute are not recorded
+	store = NewMemoryCapacitySampleStore(0)
+	forecaster = NewCapacityForecaster(client, store)
+	mockMetricValues(PoolCapacityMetricPaths.SizeUsed, float64(100), float64(110), float64(120))
+	mockMetricValues(PoolCapacityMetricPaths.SizeSubscribed, float64(300), float64(310), nil)
+	mockMetricValues(PoolCapacityMetricPaths.SizeFree, float64(900), nil, float64(880))
+	count, err = forecaster.ImportPoolMetricValues(ctx, "pool_1")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, count)
+	samples, _ = store.Samples(ctx, "pool_1")
+	assert.Equal(t, []CapacitySample{{Timestamp: forecastStart, SizeUsed: 100, SizeSubscribed: 300, SizeFree: 900}}, samples)
+
+	// Negative case
+	apiClient.On("DoWithHeaders", anyArgs...).Return(errors.New("metric not found")).Once()
+	_, err = forecaster.ImportPoolMetricValues(ctx, "pool_1")
+	assert.Error(t, err)
+}
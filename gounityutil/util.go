@@ -189,6 +189,48 @@ func ValidateDuration(durationStr string) (uint64, error) {
 	return 0, nil
 }
 
+var sizeSuffixes = []struct {
+	suffix     string
+	multiplier uint64
+}{
+	{"Ki", 1 << 10},
+	{"Mi", 1 << 20},
+	{"Gi", 1 << 30},
+	{"Ti", 1 << 40},
+	{"Pi", 1 << 50},
+}
+
+// ParseSize parses a number of bytes with an optional Ki, Mi, Gi, Ti or Pi suffix, such as 10Gi
+func ParseSize(value string) (uint64, error) {
+	value = strings.TrimSpace(value)
+	multiplier := uint64(1)
+	for _, s := range sizeSuffixes {
+		if strings.HasSuffix(value, s.suffix) {
+			value = strings.TrimSuffix(value, s.suffix)
+			multiplier = s.multiplier
+			break
+		}
+	}
+	number, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid size %q", value)
+	}
+	if number > (1<<64-1)/multiplier {
+		return 0, fmt.Errorf("size %q is too large", value)
+	}
+	return number * multiplier, nil
+}
+
+// FormatSize returns a number of bytes with the largest binary suffix dividing it
+func FormatSize(size uint64) string {
+	for i := len(sizeSuffixes) - 1; i >= 0; i-- {
+		if multiplier := sizeSuffixes[i].multiplier; size != 0 && size%multiplier == 0 {
+			return fmt.Sprintf("%d%s", size/multiplier, sizeSuffixes[i].suffix)
+		}
+	}
+	return strconv.FormatUint(size, 10)
+}
+
 // GetSecuredCipherSuites returns a slice of secured cipher suites.
 // It iterates over the tls.CipherSuites() and appends the ID of each cipher suite to the suites slice.
 // The function returns the suites slice.
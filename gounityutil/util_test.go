@@ -31,6 +31,7 @@ func TestUtils(t *testing.T) {
 	validateResourceNameTest(t)
 	validateDurationTest(t)
 	getSecuredCipherSuitesTest(t)
+	sizeTest(t)
 }
 
 func getRunIDLoggerTest(t *testing.T) {
@@ -195,6 +196,31 @@ func getSecuredCipherSuitesTest(t *testing.T) {
 	fmt.Println("Get Secured Cipher Suites Test Successful")
 }
 
+func sizeTest(t *testing.T) {
+	fmt.Println("Begin - Size Test")
+
+	for value, expected := range map[string]uint64{"512": 512, "10Gi": 10 << 30, " 3Ki ": 3 << 10, "1Pi": 1 << 50} {
+		size, err := ParseSize(value)
+		if err != nil || size != expected {
+			t.Errorf("ParseSize(%q) = %d, %v, expected %d", value, size, err, expected)
+		}
+	}
+	for size, expected := range map[uint64]string{0: "0", 1000: "1000", 1536: "1536", 10 << 30: "10Gi", 3 << 40: "3Ti"} {
+		if value := FormatSize(size); value != expected {
+			t.Errorf("FormatSize(%d) = %q, expected %q", size, value, expected)
+		}
+	}
+
+	// Negative cases
+	for _, value := range []string{"", "10GB", "-1Ki", "16384Pi"} {
+		if _, err := ParseSize(value); err == nil {
+			t.Errorf("ParseSize(%q) expected an error", value)
+		}
+	}
+
+	fmt.Println("Size Test Successful")
+}
+
 func TestChangeLogLevel(t *testing.T) {
 	// Ensure singletonLog is initialized before any tests
 	GetLogger()
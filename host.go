@@ -57,7 +57,9 @@ func (c *UnityClientImpl) FindHostByName(ctx context.Context, hostName string) (
 }
 
 // CreateHost Create a new Host
-func (c *UnityClientImpl) CreateHost(ctx context.Context, hostName string, tenantID string) (*types.Host, error) {
+func (c *UnityClientImpl) CreateHost(ctx context.Context, hostName string, tenantID string) (_ *types.Host, err error) {
+	ctx, span := c.startOperation(ctx, "CreateHost", attributeResourceName.String(hostName))
+	defer func() { endOperation(span, err) }()
 	if len(hostName) == 0 {
 		return nil, errors.New("hostname shouldn't be empty")
 	}
@@ -76,7 +78,7 @@ func (c *UnityClientImpl) CreateHost(ctx context.Context, hostName string, tenan
 	}
 
 	hostResp := &types.Host{}
-	err := c.executeWithRetryAuthenticate(ctx, http.MethodPost, fmt.Sprintf(api.UnityAPIInstanceTypeResources, api.HostAction), hostReq, hostResp)
+	err = c.executeWithRetryAuthenticate(ctx, http.MethodPost, fmt.Sprintf(api.UnityAPIInstanceTypeResources, api.HostAction), hostReq, hostResp)
 	if err != nil {
 		return nil, err
 	}
@@ -84,13 +86,17 @@ func (c *UnityClientImpl) CreateHost(ctx context.Context, hostName string, tenan
 }
 
 // DeleteHost function is used only in unit tests
-func (c *UnityClientImpl) DeleteHost(ctx context.Context, hostName string) error {
+func (c *UnityClientImpl) DeleteHost(ctx context.Context, hostName string) (err error) {
+	ctx, span := c.startOperation(ctx, "DeleteHost", attributeResourceName.String(hostName))
+	defer func() { endOperation(span, err) }()
 	if len(hostName) == 0 {
 		return fmt.Errorf("hostname shouldn't be empty")
 	}
 
 	hostResp := &types.Host{}
-	err := c.executeWithRetryAuthenticate(ctx, http.MethodDelete, fmt.Sprintf(api.UnityAPIGetResourceByNameURI, api.HostAction, hostName), nil, hostResp)
+	// the initiators of the host are detached from it
+	defer c.resourceCache.invalidate(cacheInitiators)
+	err = c.executeWithRetryAuthenticate(ctx, http.MethodDelete, fmt.Sprintf(api.UnityAPIGetResourceByNameURI, api.HostAction, hostName), nil, hostResp)
 	if err != nil {
 		return err
 	}
@@ -98,7 +104,9 @@ func (c *UnityClientImpl) DeleteHost(ctx context.Context, hostName string) error
 }
 
 // CreateHostIPPort - Create Host IP Port
-func (c *UnityClientImpl) CreateHostIPPort(ctx context.Context, hostID, ip string) (*types.HostIPPort, error) {
+func (c *UnityClientImpl) CreateHostIPPort(ctx context.Context, hostID, ip string) (_ *types.HostIPPort, err error) {
+	ctx, span := c.startOperation(ctx, "CreateHostIPPort", attributeResourceID.String(hostID))
+	defer func() { endOperation(span, err) }()
 	if len(hostID) == 0 {
 		return nil, errors.New("host ID shouldn't be empty")
 	}
@@ -113,7 +121,7 @@ func (c *UnityClientImpl) CreateHostIPPort(ctx context.Context, hostID, ip strin
 	}
 
 	hostIPResp := &types.HostIPPort{}
-	err := c.executeWithRetryAuthenticate(ctx, http.MethodPost, fmt.Sprintf(api.UnityAPIInstanceTypeResources, api.HostIPPortAction), hostIPReq, hostIPResp)
+	err = c.executeWithRetryAuthenticate(ctx, http.MethodPost, fmt.Sprintf(api.UnityAPIInstanceTypeResources, api.HostIPPortAction), hostIPReq, hostIPResp)
 	if err != nil {
 		return nil, err
 	}
@@ -130,11 +138,22 @@ func (c *UnityClientImpl) FindHostIPPortByID(ctx context.Context, hostIPID strin
 	return hostIPResp, nil
 }
 
+// ListHosts lists all the hosts of the array with their initiators and IP ports
+// - Example: GET /api/types/host/instances?fields=id,name,description,fcHostInitiators,iscsiHostInitiators,hostIPPorts?fields
+func (c *UnityClientImpl) ListHosts(ctx context.Context) ([]types.Host, error) {
+	hostsResp := &types.ListHosts{}
+	err := c.executeWithRetryAuthenticate(ctx, http.MethodGet, fmt.Sprintf(api.UnityAPIInstanceTypeResourcesWithFields, api.HostAction, HostfieldsToQuery), nil, hostsResp)
+	if err != nil {
+		return nil, fmt.Errorf("list hosts failed. Error: %v", err)
+	}
+	return hostsResp.Hosts, nil
+}
+
 // ListHostInitiators lists all host initiators
 func (c *UnityClientImpl) ListHostInitiators(ctx context.Context) ([]types.HostInitiator, error) {
 	listInitiatorResp := &types.ListHostInitiator{}
 	hostInitiatorURI := api.UnityListHostInitiatorsURI + HostInitiatorsDisplayFields
-	err := c.executeWithRetryAuthenticate(ctx, http.MethodGet, hostInitiatorURI, nil, listInitiatorResp)
+	err := c.getCached(ctx, cacheInitiators, hostInitiatorURI, listInitiatorResp)
 	if err != nil {
 		return nil, err
 	}
@@ -172,7 +191,7 @@ func (c *UnityClientImpl) FindHostInitiatorByName(ctx context.Context, wwnOrIqn
 // FindHostInitiatorByID - Find Host Initiator
 func (c *UnityClientImpl) FindHostInitiatorByID(ctx context.Context, wwnOrIqn string) (*types.HostInitiator, error) {
 	hostInitiatorResp := &types.HostInitiator{}
-	err := c.executeWithRetryAuthenticate(ctx, http.MethodGet, fmt.Sprintf(api.UnityAPIGetResourceWithFieldsURI, api.HostInitiatorAction, wwnOrIqn, HostInitiatorsDisplayFields), nil, hostInitiatorResp)
+	err := c.getCached(ctx, cacheInitiators, fmt.Sprintf(api.UnityAPIGetResourceWithFieldsURI, api.HostInitiatorAction, wwnOrIqn, HostInitiatorsDisplayFields), hostInitiatorResp)
 	if err != nil {
 		return nil, fmt.Errorf("unable to find host %s : %v", wwnOrIqn, err)
 	}
@@ -180,7 +199,9 @@ func (c *UnityClientImpl) FindHostInitiatorByID(ctx context.Context, wwnOrIqn st
 }
 
 // CreateHostInitiator - Create Host Initiator
-func (c *UnityClientImpl) CreateHostInitiator(ctx context.Context, hostID, wwnOrIqn string, initiatorType types.InitiatorType) (*types.HostInitiator, error) {
+func (c *UnityClientImpl) CreateHostInitiator(ctx context.Context, hostID, wwnOrIqn string, initiatorType types.InitiatorType) (_ *types.HostInitiator, err error) {
+	ctx, span := c.startOperation(ctx, "CreateHostInitiator", attributeResourceName.String(wwnOrIqn))
+	defer func() { endOperation(span, err) }()
 	log := util.GetRunIDLogger(ctx)
 	if len(hostID) == 0 {
 		return nil, errors.New("host ID shouldn't be empty")
@@ -206,6 +227,7 @@ func (c *UnityClientImpl) CreateHostInitiator(ctx context.Context, hostID, wwnOr
 			InitiatorType: initiatorType,
 			InitiatorWwn:  wwnOrIqn,
 		}
+		defer c.resourceCache.invalidate(cacheInitiators)
 		err := c.executeWithRetryAuthenticate(ctx, http.MethodPost, fmt.Sprintf(api.UnityAPIInstanceTypeResources, api.HostInitiatorAction), hostInitiatorReq, hostInitiatorResp)
 		if err != nil {
 			return nil, fmt.Errorf("create Host Initiator %s Error: %v", wwnOrIqn, err)
@@ -237,7 +259,9 @@ func (c *UnityClientImpl) ModifyHostInitiator(ctx context.Context, hostID string
 }
 
 // ModifyHostInitiatorByID function modifies host initiator by ID
-func (c *UnityClientImpl) ModifyHostInitiatorByID(ctx context.Context, hostID, initiatorID string) (*types.HostInitiator, error) {
+func (c *UnityClientImpl) ModifyHostInitiatorByID(ctx context.Context, hostID, initiatorID string) (_ *types.HostInitiator, err error) {
+	ctx, span := c.startOperation(ctx, "ModifyHostInitiatorByID", attributeResourceID.String(initiatorID))
+	defer func() { endOperation(span, err) }()
 	if hostID == "" {
 		return nil, errors.New("Host ID shouldn't be null")
 	}
@@ -253,13 +277,30 @@ func (c *UnityClientImpl) ModifyHostInitiatorByID(ctx context.Context, hostID, i
 		HostIDContent: &hostIDContent,
 	}
 	hostInitiatorResp := &types.HostInitiator{}
-	err := c.executeWithRetryAuthenticate(ctx, http.MethodPost, fmt.Sprintf(api.UnityModifyHostInitiators, initiatorID), hostInitiatorReq, hostInitiatorResp)
+	defer c.resourceCache.invalidate(cacheInitiators)
+	err = c.executeWithRetryAuthenticate(ctx, http.MethodPost, fmt.Sprintf(api.UnityModifyHostInitiators, initiatorID), hostInitiatorReq, hostInitiatorResp)
 	if err != nil {
 		return nil, err
 	}
 	return hostInitiatorResp, nil
 }
 
+// DeleteHostInitiator deletes the host initiator with the given ID
+func (c *UnityClientImpl) DeleteHostInitiator(ctx context.Context, initiatorID string) (err error) {
+	ctx, span := c.startOperation(ctx, "DeleteHostInitiator", attributeResourceID.String(initiatorID))
+	defer func() { endOperation(span, err) }()
+	if initiatorID == "" {
+		return errors.New("Initiator ID shouldn't be null")
+	}
+
+	defer c.resourceCache.invalidate(cacheInitiators)
+	err = c.executeWithRetryAuthenticate(ctx, http.MethodDelete, fmt.Sprintf(api.UnityAPIGetResourceURI, api.HostInitiatorAction, initiatorID), nil, nil)
+	if err != nil {
+		return fmt.Errorf("delete host initiator %s failed. Error: %v", initiatorID, err)
+	}
+	return nil
+}
+
 // FindHostInitiatorPathByID Finds Host Initiator
 func (c *UnityClientImpl) FindHostInitiatorPathByID(ctx context.Context, initiatorPathID string) (*types.HostInitiatorPath, error) {
 	hostInitiatorPathResp := &types.HostInitiatorPath{}
@@ -283,7 +324,7 @@ func (c *UnityClientImpl) FindFcPortByID(ctx context.Context, fcPortID string) (
 // FindTenants finds tenants
 func (c *UnityClientImpl) FindTenants(ctx context.Context) (*types.TenantInfo, error) {
 	tenantsResp := &types.TenantInfo{}
-	err := c.executeWithRetryAuthenticate(ctx, http.MethodGet, fmt.Sprintf(api.UnityAPIGetTenantURI, api.TenantAction, TenantDisplayFields), nil, tenantsResp)
+	err := c.getCached(ctx, cacheTenants, fmt.Sprintf(api.UnityAPIGetTenantURI, api.TenantAction, TenantDisplayFields), tenantsResp)
 	if err != nil {
 		return nil, fmt.Errorf("unable to find tenants : %v", err)
 	}
@@ -350,6 +350,24 @@ func TestModifyHostInitiatorByID(t *testing.T) {
 	fmt.Println("Modify Host Initiator By ID Test Successful")
 }
 
+func TestDeleteHostInitiator(t *testing.T) {
+	fmt.Println("Begin - Delete Host Initiator Test")
+	ctx := context.Background()
+
+	err := testConf.client.DeleteHostInitiator(ctx, "")
+	assert.Equal(t, errors.New("Initiator ID shouldn't be null"), err)
+
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", mock.Anything, "DELETE", "/api/instances/hostInitiator/HostInitiator_1", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+	err = testConf.client.DeleteHostInitiator(ctx, "HostInitiator_1")
+	assert.NoError(t, err)
+
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", anyArgs...).Return(errors.New("error")).Once()
+	err = testConf.client.DeleteHostInitiator(ctx, "HostInitiator_1")
+	assert.ErrorContains(t, err, "delete host initiator HostInitiator_1 failed")
+
+	fmt.Println("Delete Host Initiator Test Successful")
+}
+
 func TestFindHostInitiatorPathByID(t *testing.T) {
 	fmt.Println("Begin - Find Initiator Path Test")
 
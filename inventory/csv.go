@@ -0,0 +1,129 @@
+/*
+ Copyright © 2025 Dell Inc. or its subsidiaries. All Rights Reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package inventory
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// listSeparator joins the values of a list in a CSV cell
+const listSeparator = ";"
+
+func formatUint(value uint64) string {
+	return strconv.FormatUint(value, 10)
+}
+
+func formatPercent(value float64) string {
+	return strconv.FormatFloat(value, 'f', 2, 64)
+}
+
+func formatTime(value time.Time) string {
+	if value.IsZero() {
+		return ""
+	}
+	return value.UTC().Format(time.RFC3339)
+}
+
+// neutralize prefixes a cell starting with a formula character with a quote, so that spreadsheets opening the CSV
+// do not evaluate names and descriptions set on the array as formulas
+func neutralize(cells []string) []string {
+	for i, cell := range cells {
+		if cell != "" && strings.ContainsRune("=+-@\t\r", rune(cell[0])) {
+			cells[i] = "'" + cell
+		}
+	}
+	return cells
+}
+
+// writeCSV writes the header and a row per record
+func writeCSV(w io.Writer, header []string, n int, row func(i int) []string) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(header); err != nil {
+		return err
+	}
+	for i := 0; i < n; i++ {
+		if err := writer.Write(neutralize(row(i))); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// WriteVolumesCSV writes the volumes as CSV with a header row. Lists are joined with semicolons.
+func WriteVolumesCSV(w io.Writer, volumes []Volume) error {
+	header := []string{"id", "name", "description", "pool", "wwn", "sizeTotal", "sizeUsed", "sizeAllocated", "usedPercent", "thinSavings", "thin", "dataReduction", "thinClone", "hosts"}
+	return writeCSV(w, header, len(volumes), func(i int) []string {
+		v := volumes[i]
+		return []string{
+			v.ID, v.Name, v.Description, v.Pool, v.WWN, formatUint(v.SizeTotal), formatUint(v.SizeUsed), formatUint(v.SizeAllocated),
+			formatPercent(v.UsedPercent), formatUint(v.ThinSavings), strconv.FormatBool(v.Thin), strconv.FormatBool(v.DataReduction),
+			strconv.FormatBool(v.ThinClone), strings.Join(v.Hosts, listSeparator),
+		}
+	})
+}
+
+// WriteFilesystemsCSV writes the filesystems as CSV with a header row. Lists are joined with semicolons.
+func WriteFilesystemsCSV(w io.Writer, filesystems []Filesystem) error {
+	header := []string{"id", "name", "description", "pool", "nasServer", "sizeTotal", "sizeUsed", "sizeAllocated", "usedPercent", "thinSavings", "thin", "dataReduction", "nfsShares"}
+	return writeCSV(w, header, len(filesystems), func(i int) []string {
+		fs := filesystems[i]
+		return []string{
+			fs.ID, fs.Name, fs.Description, fs.Pool, fs.NASServer, formatUint(fs.SizeTotal), formatUint(fs.SizeUsed), formatUint(fs.SizeAllocated),
+			formatPercent(fs.UsedPercent), formatUint(fs.ThinSavings), strconv.FormatBool(fs.Thin), strconv.FormatBool(fs.DataReduction),
+			strings.Join(fs.NFSShares, listSeparator),
+		}
+	})
+}
+
+// WriteNFSSharesCSV writes the NFS shares as CSV with a header row. Lists are joined with semicolons.
+func WriteNFSSharesCSV(w io.Writer, shares []NFSShare) error {
+	header := []string{"id", "name", "path", "filesystem", "snapshot", "exportPaths", "readOnlyHosts", "readWriteHosts", "readOnlyRootHosts", "rootHosts"}
+	return writeCSV(w, header, len(shares), func(i int) []string {
+		s := shares[i]
+		return []string{
+			s.ID, s.Name, s.Path, s.Filesystem, s.Snapshot, strings.Join(s.ExportPaths, listSeparator),
+			strings.Join(s.ReadOnlyHosts, listSeparator), strings.Join(s.ReadWriteHosts, listSeparator),
+			strings.Join(s.ReadOnlyRootHosts, listSeparator), strings.Join(s.RootHosts, listSeparator),
+		}
+	})
+}
+
+// WriteSnapshotsCSV writes the snapshots as CSV with a header row. Times are in RFC 3339 format.
+func WriteSnapshotsCSV(w io.Writer, snapshots []Snapshot) error {
+	header := []string{"id", "name", "source", "size", "creationTime", "expirationTime", "autoDelete"}
+	return writeCSV(w, header, len(snapshots), func(i int) []string {
+		s := snapshots[i]
+		return []string{
+			s.ID, s.Name, s.Source, formatUint(s.Size), formatTime(s.CreationTime), formatTime(s.ExpirationTime), strconv.FormatBool(s.AutoDelete),
+		}
+	})
+}
+
+// WriteHostsCSV writes the hosts as CSV with a header row. Lists are joined with semicolons.
+func WriteHostsCSV(w io.Writer, hosts []Host) error {
+	header := []string{"id", "name", "description", "initiators", "ipAddresses", "volumes"}
+	return writeCSV(w, header, len(hosts), func(i int) []string {
+		h := hosts[i]
+		return []string{
+			h.ID, h.Name, h.Description, strings.Join(h.Initiators, listSeparator), strings.Join(h.IPAddresses, listSeparator),
+			strings.Join(h.Volumes, listSeparator),
+		}
+	})
+}
@@ -0,0 +1,135 @@
+/*
+ Copyright © 2025 Dell Inc. or its subsidiaries. All Rights Reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package inventory
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// ChangeAction tells how a resource changed between two inventories
+type ChangeAction string
+
+// ChangeAction constants
+const (
+	ChangeAdded    ChangeAction = "added"
+	ChangeRemoved  ChangeAction = "removed"
+	ChangeModified ChangeAction = "modified"
+)
+
+// FieldChange is a field of a resource whose value changed
+type FieldChange struct {
+	// Field is the JSON name of the field
+	Field string
+	Old   interface{}
+	New   interface{}
+}
+
+// Change is a resource added, removed or modified between two inventories
+type Change struct {
+	// Kind is the JSON name of the resource list, such as volumes
+	Kind   string
+	ID     string
+	Name   string
+	Action ChangeAction
+	// Fields are the changed fields of a modified resource
+	Fields []FieldChange
+}
+
+func (c Change) String() string {
+	s := fmt.Sprintf("%s %s %s (%s)", c.Action, c.Kind, c.Name, c.ID)
+	if len(c.Fields) > 0 {
+		fields := make([]string, 0, len(c.Fields))
+		for _, field := range c.Fields {
+			fields = append(fields, fmt.Sprintf("%s: %v -> %v", field.Field, field.Old, field.New))
+		}
+		s += ": " + strings.Join(fields, ", ")
+	}
+	return s
+}
+
+// usageFields change with every write to a resource and are ignored by Diff
+var usageFields = map[string]bool{
+	"sizeUsed":      true,
+	"sizeAllocated": true,
+	"usedPercent":   true,
+	"thinSavings":   true,
+}
+
+// Diff returns the resources added, removed or modified from before to after, by kind then ID. Resources are matched by ID.
+// Usage fields such as sizeUsed and usedPercent are ignored since they change with every write.
+func Diff(before, after *Inventory) []Change {
+	var changes []Change
+	changes = append(changes, diffResources("volumes", before.Volumes, after.Volumes, func(v Volume) (string, string) { return v.ID, v.Name })...)
+	changes = append(changes, diffResources("filesystems", before.Filesystems, after.Filesystems, func(fs Filesystem) (string, string) { return fs.ID, fs.Name })...)
+	changes = append(changes, diffResources("nfsShares", before.NFSShares, after.NFSShares, func(s NFSShare) (string, string) { return s.ID, s.Name })...)
+	changes = append(changes, diffResources("snapshots", before.Snapshots, after.Snapshots, func(s Snapshot) (string, string) { return s.ID, s.Name })...)
+	changes = append(changes, diffResources("hosts", before.Hosts, after.Hosts, func(h Host) (string, string) { return h.ID, h.Name })...)
+	return changes
+}
+
+// diffResources compares two lists of resources of a kind
+func diffResources[T any](kind string, before, after []T, key func(T) (string, string)) []Change {
+	oldByID := map[string]T{}
+	for _, resource := range before {
+		id, _ := key(resource)
+		oldByID[id] = resource
+	}
+	newByID := map[string]T{}
+	for _, resource := range after {
+		id, _ := key(resource)
+		newByID[id] = resource
+	}
+
+	var changes []Change
+	for id, resource := range oldByID {
+		if _, ok := newByID[id]; !ok {
+			_, name := key(resource)
+			changes = append(changes, Change{Kind: kind, ID: id, Name: name, Action: ChangeRemoved})
+		}
+	}
+	for id, resource := range newByID {
+		_, name := key(resource)
+		previous, ok := oldByID[id]
+		if !ok {
+			changes = append(changes, Change{Kind: kind, ID: id, Name: name, Action: ChangeAdded})
+			continue
+		}
+		if fields := diffFields(previous, resource); len(fields) > 0 {
+			changes = append(changes, Change{Kind: kind, ID: id, Name: name, Action: ChangeModified, Fields: fields})
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool { return changes[i].ID < changes[j].ID })
+	return changes
+}
+
+// diffFields returns the fields of two resources of the same type which differ, in declaration order
+func diffFields(before, after interface{}) []FieldChange {
+	oldValue, newValue := reflect.ValueOf(before), reflect.ValueOf(after)
+	var fields []FieldChange
+	for i := 0; i < oldValue.NumField(); i++ {
+		name, _, _ := strings.Cut(oldValue.Type().Field(i).Tag.Get("json"), ",")
+		if usageFields[name] {
+			continue
+		}
+		oldField, newField := oldValue.Field(i).Interface(), newValue.Field(i).Interface()
+		if !reflect.DeepEqual(oldField, newField) {
+			fields = append(fields, FieldChange{Field: name, Old: oldField, New: newField})
+		}
+	}
+	return fields
+}
@@ -0,0 +1,312 @@
+/*
+ Copyright © 2025 Dell Inc. or its subsidiaries. All Rights Reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+// Package inventory lists the volumes, filesystems, NFS shares, snapshots and hosts of a Unity array in a versioned
+// JSON document, writes them as CSV and compares two inventories.
+package inventory
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/dell/gounity"
+	types "github.com/dell/gounity/apitypes"
+	util "github.com/dell/gounity/gounityutil"
+)
+
+// SchemaVersion is the version of the inventory document. It changes when fields are renamed or removed.
+const SchemaVersion = 1
+
+// Inventory is a listing of the resources of an array at a point in time
+type Inventory struct {
+	SchemaVersion int       `json:"schemaVersion"`
+	CollectedAt   time.Time `json:"collectedAt"`
+	// Array identifies the array, such as its endpoint. It is left to the caller to set.
+	Array       string       `json:"array,omitempty"`
+	Volumes     []Volume     `json:"volumes"`
+	Filesystems []Filesystem `json:"filesystems"`
+	NFSShares   []NFSShare   `json:"nfsShares"`
+	Snapshots   []Snapshot   `json:"snapshots"`
+	Hosts       []Host       `json:"hosts"`
+}
+
+// Volume is a LUN. UsedPercent, ThinSavings and Hosts are computed.
+type Volume struct {
+	ID            string  `json:"id"`
+	Name          string  `json:"name"`
+	Description   string  `json:"description,omitempty"`
+	Pool          string  `json:"pool"`
+	WWN           string  `json:"wwn,omitempty"`
+	SizeTotal     uint64  `json:"sizeTotal"`
+	SizeUsed      uint64  `json:"sizeUsed"`
+	SizeAllocated uint64  `json:"sizeAllocated"`
+	UsedPercent   float64 `json:"usedPercent"`
+	// ThinSavings is the size of a thin LUN which is not allocated in the pool
+	ThinSavings   uint64 `json:"thinSavings"`
+	Thin          bool   `json:"thin"`
+	DataReduction bool   `json:"dataReduction"`
+	ThinClone     bool   `json:"thinClone"`
+	// Hosts are the names of the hosts having access to the LUN
+	Hosts []string `json:"hosts,omitempty"`
+}
+
+// Filesystem is a filesystem. UsedPercent, ThinSavings and NFSShares are computed.
+type Filesystem struct {
+	ID            string  `json:"id"`
+	Name          string  `json:"name"`
+	Description   string  `json:"description,omitempty"`
+	Pool          string  `json:"pool"`
+	NASServer     string  `json:"nasServer"`
+	SizeTotal     uint64  `json:"sizeTotal"`
+	SizeUsed      uint64  `json:"sizeUsed"`
+	SizeAllocated uint64  `json:"sizeAllocated"`
+	UsedPercent   float64 `json:"usedPercent"`
+	// ThinSavings is the size of a thin filesystem which is not allocated in the pool
+	ThinSavings   uint64 `json:"thinSavings"`
+	Thin          bool   `json:"thin"`
+	DataReduction bool   `json:"dataReduction"`
+	// NFSShares are the names of the NFS shares of the filesystem and its snapshots
+	NFSShares []string `json:"nfsShares,omitempty"`
+}
+
+// NFSShare is an NFS share. The host lists hold host names.
+type NFSShare struct {
+	ID         string `json:"id"`
+	Name       string `json:"name"`
+	Path       string `json:"path,omitempty"`
+	Filesystem string `json:"filesystem"`
+	// Snapshot is the name of the snapshot exported by the share, if any
+	Snapshot          string   `json:"snapshot,omitempty"`
+	ExportPaths       []string `json:"exportPaths,omitempty"`
+	ReadOnlyHosts     []string `json:"readOnlyHosts,omitempty"`
+	ReadWriteHosts    []string `json:"readWriteHosts,omitempty"`
+	ReadOnlyRootHosts []string `json:"readOnlyRootHosts,omitempty"`
+	RootHosts         []string `json:"rootHosts,omitempty"`
+}
+
+// Snapshot is a snapshot of a LUN or filesystem
+type Snapshot struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	// Source is the name of the LUN or filesystem of the snapshot
+	Source         string    `json:"source"`
+	Size           uint64    `json:"size"`
+	CreationTime   time.Time `json:"creationTime"`
+	ExpirationTime time.Time `json:"expirationTime,omitzero"`
+	AutoDelete     bool      `json:"autoDelete"`
+}
+
+// Host is a host with its initiators. Volumes is computed.
+type Host struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	// Initiators are the WWNs and IQNs of the host
+	Initiators  []string `json:"initiators,omitempty"`
+	IPAddresses []string `json:"ipAddresses,omitempty"`
+	// Volumes are the names of the LUNs the host has access to
+	Volumes []string `json:"volumes,omitempty"`
+}
+
+// usedPercent returns used as a percentage of total, rounded to two decimals
+func usedPercent(used, total uint64) float64 {
+	if total == 0 {
+		return 0
+	}
+	return math.Round(float64(used)/float64(total)*10000) / 100
+}
+
+// thinSavings returns the size of a thin resource which is not allocated
+func thinSavings(thin bool, total, allocated uint64) uint64 {
+	if !thin || allocated >= total {
+		return 0
+	}
+	return total - allocated
+}
+
+// nameOf returns the name of the resource with the ID, or the ID if the name is not known
+func nameOf(names map[string]string, id string) string {
+	if name, ok := names[id]; ok && name != "" {
+		return name
+	}
+	return id
+}
+
+// hostNamesOf returns the sorted names of the hosts
+func hostNamesOf(names map[string]string, hosts []types.HostContent) []string {
+	var hostNames []string
+	for _, host := range hosts {
+		hostNames = append(hostNames, nameOf(names, host.ID))
+	}
+	sort.Strings(hostNames)
+	return hostNames
+}
+
+// Collect lists every volume, filesystem, NFS share, snapshot and host of the array. The host access of NFS shares is
+// read share by share.
+func Collect(ctx context.Context, client gounity.UnityClient) (*Inventory, error) {
+	log := util.GetRunIDLogger(ctx)
+	inventory := &Inventory{SchemaVersion: SchemaVersion, CollectedAt: time.Now().UTC()}
+
+	pools, err := client.ListStoragePools(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list pools: %v", err)
+	}
+	poolNames := map[string]string{}
+	for _, pool := range pools {
+		poolNames[pool.StoragePoolContent.ID] = pool.StoragePoolContent.Name
+	}
+	hosts, err := client.ListHosts(ctx)
+	if err != nil {
+		return nil, err
+	}
+	hostNames := map[string]string{}
+	for _, host := range hosts {
+		hostNames[host.HostContent.ID] = host.HostContent.Name
+	}
+	initiators, err := client.ListHostInitiators(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list host initiators: %v", err)
+	}
+	volumes, _, err := client.ListVolumes(ctx, 0, 0)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list volumes: %v", err)
+	}
+	filesystems, err := client.ListFilesystems(ctx)
+	if err != nil {
+		return nil, err
+	}
+	snapshots, _, err := client.ListSnapshots(ctx, 0, 0, "", "")
+	if err != nil {
+		return nil, fmt.Errorf("unable to list snapshots: %v", err)
+	}
+
+	// sourceNames maps the storage resource IDs of LUNs and filesystems to their names
+	sourceNames := map[string]string{}
+	hostVolumes := map[string][]string{}
+	for _, volume := range volumes {
+		content := volume.VolumeContent
+		sourceNames[content.ResourceID] = content.Name
+		v := Volume{
+			ID: content.ResourceID, Name: content.Name, Description: content.Description,
+			Pool: nameOf(poolNames, content.Pool.ID), WWN: content.Wwn,
+			SizeTotal: content.SizeTotal, SizeUsed: content.SizeUsed, SizeAllocated: content.SizeAllocated,
+			UsedPercent: usedPercent(content.SizeUsed, content.SizeTotal),
+			ThinSavings: thinSavings(content.IsThinEnabled, content.SizeTotal, content.SizeAllocated),
+			Thin:        content.IsThinEnabled, DataReduction: content.IsDataReductionEnabled, ThinClone: content.IsThinClone,
+		}
+		for _, access := range content.HostAccessResponse {
+			v.Hosts = append(v.Hosts, nameOf(hostNames, access.HostContent.ID))
+			hostVolumes[access.HostContent.ID] = append(hostVolumes[access.HostContent.ID], content.Name)
+		}
+		sort.Strings(v.Hosts)
+		inventory.Volumes = append(inventory.Volumes, v)
+	}
+
+	snapshotNames := map[string]string{}
+	for _, snapshot := range snapshots {
+		snapshotNames[snapshot.SnapshotContent.ResourceID] = snapshot.SnapshotContent.Name
+	}
+	for _, filesystem := range filesystems {
+		content := filesystem.FileContent
+		sourceNames[content.StorageResource.ID] = content.Name
+		fs := Filesystem{
+			ID: content.ID, Name: content.Name, Description: content.Description,
+			Pool: nameOf(poolNames, content.Pool.ID), NASServer: content.NASServer.ID,
+			SizeTotal: content.SizeTotal, SizeUsed: content.SizeUsed, SizeAllocated: content.SizeAllocated,
+			UsedPercent: usedPercent(content.SizeUsed, content.SizeTotal),
+			ThinSavings: thinSavings(content.IsThinEnabled, content.SizeTotal, content.SizeAllocated),
+			Thin:        content.IsThinEnabled, DataReduction: content.IsDataReductionEnabled,
+		}
+		for _, share := range content.NFSShare {
+			fs.NFSShares = append(fs.NFSShares, share.Name)
+			nfsShare, err := client.FindNFSShareByID(ctx, share.ID)
+			if err != nil {
+				return nil, fmt.Errorf("unable to read NFS share %s: %v", share.ID, err)
+			}
+			shareContent := nfsShare.NFSShareContent
+			s := NFSShare{
+				ID: share.ID, Name: share.Name, Path: share.Path, Filesystem: content.Name,
+				ExportPaths:       shareContent.ExportPaths,
+				ReadOnlyHosts:     hostNamesOf(hostNames, shareContent.ReadOnlyHosts),
+				ReadWriteHosts:    hostNamesOf(hostNames, shareContent.ReadWriteHosts),
+				ReadOnlyRootHosts: hostNamesOf(hostNames, shareContent.ReadOnlyRootAccessHosts),
+				RootHosts:         hostNamesOf(hostNames, shareContent.RootAccessHosts),
+			}
+			if share.ParentSnap.ID != "" {
+				s.Snapshot = nameOf(snapshotNames, share.ParentSnap.ID)
+			}
+			inventory.NFSShares = append(inventory.NFSShares, s)
+		}
+		inventory.Filesystems = append(inventory.Filesystems, fs)
+	}
+
+	for _, snapshot := range snapshots {
+		content := snapshot.SnapshotContent
+		inventory.Snapshots = append(inventory.Snapshots, Snapshot{
+			ID: content.ResourceID, Name: content.Name, Source: nameOf(sourceNames, content.StorageResource.ID),
+			Size: uint64(content.Size), CreationTime: content.CreationTime, ExpirationTime: content.ExpirationTime,
+			AutoDelete: content.IsAutoDelete,
+		})
+	}
+
+	hostInitiators := map[string][]string{}
+	for _, initiator := range initiators {
+		content := initiator.HostInitiatorContent
+		if content.ParentHost.ID != "" {
+			hostInitiators[content.ParentHost.ID] = append(hostInitiators[content.ParentHost.ID], content.InitiatorID)
+		}
+	}
+	for _, host := range hosts {
+		content := host.HostContent
+		h := Host{
+			ID: content.ID, Name: content.Name, Description: content.Description,
+			Initiators: hostInitiators[content.ID], Volumes: hostVolumes[content.ID],
+		}
+		for _, port := range content.IPPorts {
+			h.IPAddresses = append(h.IPAddresses, port.Address)
+		}
+		sort.Strings(h.Initiators)
+		sort.Strings(h.Volumes)
+		inventory.Hosts = append(inventory.Hosts, h)
+	}
+
+	log.Infof("Collected inventory of %d volumes, %d filesystems, %d NFS shares, %d snapshots and %d hosts",
+		len(inventory.Volumes), len(inventory.Filesystems), len(inventory.NFSShares), len(inventory.Snapshots), len(inventory.Hosts))
+	return inventory, nil
+}
+
+// WriteJSON writes the inventory as an indented JSON document
+func (inv *Inventory) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(inv)
+}
+
+// ReadJSON reads an inventory document written by WriteJSON. Documents of a newer schema version are refused.
+func ReadJSON(r io.Reader) (*Inventory, error) {
+	inventory := &Inventory{}
+	if err := json.NewDecoder(r).Decode(inventory); err != nil {
+		return nil, fmt.Errorf("unable to parse inventory: %v", err)
+	}
+	if inventory.SchemaVersion < 1 || inventory.SchemaVersion > SchemaVersion {
+		return nil, fmt.Errorf("unsupported inventory schema version %d", inventory.SchemaVersion)
+	}
+	return inventory, nil
+}
@@ -0,0 +1,175 @@
+/*
+ Copyright © 2025 Dell Inc. or its subsidiaries. All Rights Reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package inventory
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	types "github.com/dell/gounity/apitypes"
+	"github.com/dell/gounity/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var created = time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+
+// arrayClient returns a mock client of an array with a LUN exported to a host and a filesystem with a snapshot
+// exported by an NFS share
+func arrayClient() *mocks.UnityClient {
+	client := &mocks.UnityClient{}
+	client.On("ListStoragePools", mock.Anything).Return([]types.StoragePool{
+		{StoragePoolContent: types.StoragePoolContent{ID: "pool_1", Name: "pool1"}},
+	}, nil)
+	client.On("ListHosts", mock.Anything).Return([]types.Host{
+		{HostContent: types.HostContent{ID: "Host_1", Name: "node1", IPPorts: []types.IPPorts{{ID: "HostNetworkAddress_1", Address: "10.0.0.1"}}}},
+	}, nil)
+	client.On("ListHostInitiators", mock.Anything).Return([]types.HostInitiator{
+		{HostInitiatorContent: types.HostInitiatorContent{ID: "HostInitiator_1", InitiatorID: "iqn.a", ParentHost: types.HostContent{ID: "Host_1"}}},
+		{HostInitiatorContent: types.HostInitiatorContent{ID: "HostInitiator_2", InitiatorID: "iqn.b"}},
+	}, nil)
+	client.On("ListVolumes", mock.Anything, 0, 0).Return([]types.Volume{
+		{VolumeContent: types.VolumeContent{
+			ResourceID: "sv_1", Name: "vol1", Pool: types.Pool{ID: "pool_1"}, IsThinEnabled: true,
+			SizeTotal: 100 << 30, SizeUsed: 25 << 30, SizeAllocated: 30 << 30,
+			HostAccessResponse: []types.HostAccessResponse{{HostContent: types.HostContent{ID: "Host_1"}}},
+		}},
+	}, 1, nil)
+	client.On("ListFilesystems", mock.Anything).Return([]types.Filesystem{
+		{FileContent: types.FileContent{
+			ID: "fs_1", Name: "fs1", Pool: types.Pool{ID: "pool_1"}, NASServer: types.Pool{ID: "nas_1"}, StorageResource: types.Pool{ID: "res_1"},
+			SizeTotal: 3 << 30, SizeUsed: 1 << 30,
+			NFSShare: []types.Share{{ID: "NFSShare_1", Name: "share1", Path: "/", ParentSnap: types.StorageResource{ID: "snap_1"}}},
+		}},
+	}, nil)
+	client.On("FindNFSShareByID", mock.Anything, "NFSShare_1").Return(&types.NFSShare{NFSShareContent: types.NFSShareContent{
+		ID: "NFSShare_1", RootAccessHosts: []types.HostContent{{ID: "Host_1"}, {ID: "Host_9"}},
+	}}, nil)
+	client.On("ListSnapshots", mock.Anything, 0, 0, "", "").Return([]types.Snapshot{
+		{SnapshotContent: types.SnapshotContent{ResourceID: "snap_1", Name: "fssnap", StorageResource: types.StorageResource{ID: "res_1"}, Size: 1024, CreationTime: created}},
+	}, 1, nil)
+	return client
+}
+
+func TestCollect(t *testing.T) {
+	ctx := context.Background()
+	inventory, err := Collect(ctx, arrayClient())
+	assert.NoError(t, err)
+	assert.Equal(t, SchemaVersion, inventory.SchemaVersion)
+	assert.Equal(t, Volume{
+		ID: "sv_1", Name: "vol1", Pool: "pool1", SizeTotal: 100 << 30, SizeUsed: 25 << 30, SizeAllocated: 30 << 30,
+		UsedPercent: 25, ThinSavings: 70 << 30, Thin: true, Hosts: []string{"node1"},
+	}, inventory.Volumes[0])
+	assert.Equal(t, 33.33, inventory.Filesystems[0].UsedPercent)
+	assert.Equal(t, uint64(0), inventory.Filesystems[0].ThinSavings)
+	assert.Equal(t, []string{"share1"}, inventory.Filesystems[0].NFSShares)
+	assert.Equal(t, NFSShare{ID: "NFSShare_1", Name: "share1", Path: "/", Filesystem: "fs1", Snapshot: "fssnap", RootHosts: []string{"Host_9", "node1"}}, inventory.NFSShares[0])
+	assert.Equal(t, "fs1", inventory.Snapshots[0].Source)
+	assert.Equal(t, Host{ID: "Host_1", Name: "node1", Initiators: []string{"iqn.a"}, IPAddresses: []string{"10.0.0.1"}, Volumes: []string{"vol1"}}, inventory.Hosts[0])
+
+	buf := &bytes.Buffer{}
+	assert.NoError(t, inventory.WriteJSON(buf))
+	assert.Contains(t, buf.String(), `"schemaVersion": 1`)
+	read, err := ReadJSON(buf)
+	assert.NoError(t, err)
+	assert.Empty(t, Diff(inventory, read))
+
+	// Negative cases
+	_, err = ReadJSON(strings.NewReader(`{"schemaVersion": 2}`))
+	assert.ErrorContains(t, err, "unsupported inventory schema version 2")
+	_, err = ReadJSON(strings.NewReader(`{`))
+	assert.ErrorContains(t, err, "unable to parse inventory")
+
+	client := arrayClient()
+	client.ExpectedCalls = nil
+	client.On("ListStoragePools", mock.Anything).Return(nil, errors.New("error"))
+	_, err = Collect(ctx, client)
+	assert.ErrorContains(t, err, "unable to list pools")
+
+	client = &mocks.UnityClient{}
+	client.On("FindNFSShareByID", mock.Anything, "NFSShare_1").Return(nil, errors.New("error"))
+	client.ExpectedCalls = append(client.ExpectedCalls, arrayClient().ExpectedCalls...)
+	_, err = Collect(ctx, client)
+	assert.ErrorContains(t, err, "unable to read NFS share NFSShare_1")
+}
+
+func TestWriteCSV(t *testing.T) {
+	inventory, err := Collect(context.Background(), arrayClient())
+	assert.NoError(t, err)
+
+	buf := &bytes.Buffer{}
+	assert.NoError(t, WriteVolumesCSV(buf, inventory.Volumes))
+	assert.Equal(t, "id,name,description,pool,wwn,sizeTotal,sizeUsed,sizeAllocated,usedPercent,thinSavings,thin,dataReduction,thinClone,hosts\n"+
+		"sv_1,vol1,,pool1,,107374182400,26843545600,32212254720,25.00,75161927680,true,false,false,node1\n", buf.String())
+
+	buf.Reset()
+	assert.NoError(t, WriteNFSSharesCSV(buf, inventory.NFSShares))
+	assert.Equal(t, "NFSShare_1,share1,/,fs1,fssnap,,,,,Host_9;node1", strings.Split(buf.String(), "\n")[1])
+
+	buf.Reset()
+	assert.NoError(t, WriteSnapshotsCSV(buf, inventory.Snapshots))
+	assert.Equal(t, "snap_1,fssnap,fs1,1024,2025-01-02T03:04:05Z,,false", strings.Split(buf.String(), "\n")[1])
+
+	buf.Reset()
+	assert.NoError(t, WriteFilesystemsCSV(buf, inventory.Filesystems))
+	assert.Equal(t, "fs_1,fs1,,pool1,nas_1,3221225472,1073741824,0,33.33,0,false,false,share1", strings.Split(buf.String(), "\n")[1])
+
+	buf.Reset()
+	assert.NoError(t, WriteHostsCSV(buf, inventory.Hosts))
+	assert.Equal(t, "Host_1,node1,,iqn.a,10.0.0.1,vol1", strings.Split(buf.String(), "\n")[1])
+
+	// Cells which spreadsheets would evaluate as formulas are quoted
+	buf.Reset()
+	assert.NoError(t, WriteHostsCSV(buf, []Host{{ID: "Host_2", Name: "@node", Description: `=HYPERLINK("http://x")`, Initiators: []string{"+a", "-b"}}}))
+	assert.Equal(t, `Host_2,'@node,"'=HYPERLINK(""http://x"")",'+a;-b,,`, strings.Split(buf.String(), "\n")[1])
+
+	// A snapshot without expiration has no expiration time in JSON
+	data, err := json.Marshal(inventory.Snapshots[0])
+	assert.NoError(t, err)
+	assert.NotContains(t, string(data), "expirationTime")
+}
+
+func TestDiff(t *testing.T) {
+	before := &Inventory{
+		Volumes: []Volume{
+			{ID: "sv_1", Name: "vol1", SizeTotal: 1 << 30, SizeUsed: 1, Hosts: []string{"node1"}},
+			{ID: "sv_2", Name: "vol2"},
+		},
+		Hosts: []Host{{ID: "Host_1", Name: "node1"}},
+	}
+	after := &Inventory{
+		Volumes: []Volume{
+			{ID: "sv_1", Name: "vol1", SizeTotal: 2 << 30, SizeUsed: 2, Hosts: []string{"node1", "node2"}},
+			{ID: "sv_3", Name: "vol3"},
+		},
+		Hosts: []Host{{ID: "Host_1", Name: "node1"}},
+	}
+
+	var changes []string
+	for _, change := range Diff(before, after) {
+		changes = append(changes, change.String())
+	}
+	assert.Equal(t, []string{
+		"modified volumes vol1 (sv_1): sizeTotal: 1073741824 -> 2147483648, hosts: [node1] -> [node1 node2]",
+		"removed volumes vol2 (sv_2)",
+		"added volumes vol3 (sv_3)",
+	}, changes)
+	assert.Empty(t, Diff(after, after))
+}
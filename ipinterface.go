@@ -17,7 +17,6 @@ package gounity
 import (
 	"context"
 	"fmt"
-	"net/http"
 
 	util "github.com/dell/gounity/gounityutil"
 
@@ -30,7 +29,7 @@ func (c *UnityClientImpl) ListIscsiIPInterfaces(ctx context.Context) ([]types.IP
 	log := util.GetRunIDLogger(ctx)
 	hResponse := &types.ListIPInterfaces{}
 	log.Debugf("URI: "+api.UnityAPIInstanceTypeResourcesWithFields, api.IPInterface, IscsiIPFields)
-	err := c.executeWithRetryAuthenticate(ctx, http.MethodGet, fmt.Sprintf(api.UnityAPIInstanceTypeResourcesWithFields, api.IPInterface, IscsiIPFields), nil, hResponse)
+	err := c.getCached(ctx, cacheIPInterfaces, fmt.Sprintf(api.UnityAPIInstanceTypeResourcesWithFields, api.IPInterface, IscsiIPFields), hResponse)
 	if err != nil {
 		return nil, fmt.Errorf("unable to list Ip Interfaces %v", err)
 	}
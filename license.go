@@ -0,0 +1,135 @@
+/*
+ Copyright © 2025 Dell Inc. or its subsidiaries. All Rights Reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package gounity
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/dell/gounity/api"
+	types "github.com/dell/gounity/apitypes"
+	util "github.com/dell/gounity/gounityutil"
+)
+
+// LicenseType constants
+const (
+	Replication LicenseType = "REMOTE_REPLICATION"
+	Snapshots   LicenseType = "SNAPSHOTS"
+	FASTVP      LicenseType = "FAST_VP"
+	FASTCache   LicenseType = "FAST_CACHE"
+	QoS         LicenseType = "QOS"
+	File        LicenseType = "FILE"
+	CIFS        LicenseType = "CIFS"
+	NFS         LicenseType = "NFS"
+	ISCSI       LicenseType = "ISCSI"
+)
+
+// DefaultLicenseCacheTTL is the default time for which ListLicenses results are cached
+const DefaultLicenseCacheTTL = time.Hour
+
+// licenseCache caches the result of ListLicenses
+type licenseCache struct {
+	mutex    sync.Mutex
+	ttl      time.Duration
+	licenses []types.License
+	expires  time.Time
+}
+
+func (l *licenseCache) get() ([]types.License, bool) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	if l.licenses == nil || time.Now().After(l.expires) {
+		return nil, false
+	}
+	return append([]types.License{}, l.licenses...), true
+}
+
+func (l *licenseCache) put(licenses []types.License) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	if l.ttl <= 0 {
+		return
+	}
+	l.licenses = append([]types.License{}, licenses...)
+	l.expires = time.Now().Add(l.ttl)
+}
+
+func (l *licenseCache) setTTL(ttl time.Duration) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	l.ttl = ttl
+	l.licenses = nil
+}
+
+// SetLicenseCacheTTL sets the time for which ListLicenses results are cached.
+// A TTL of zero or less disables caching. Changing the TTL clears the cache.
+func (c *UnityClientImpl) SetLicenseCacheTTL(ttl time.Duration) {
+	c.licenseCache.setTTL(ttl)
+}
+
+// ListLicenses lists every license instance of the array. Results are cached for the configured TTL.
+// - Example: GET /api/types/license/instances?fields=id,name,isInstalled,isValid,isPermanent,version,issued,expires
+func (c *UnityClientImpl) ListLicenses(ctx context.Context) ([]types.License, error) {
+	log := util.GetRunIDLogger(ctx)
+
+	if licenses, ok := c.licenseCache.get(); ok && !isNoCache(ctx) {
+		log.Debugf("ListLicenses: returning %d cached licenses", len(licenses))
+		return licenses, nil
+	}
+
+	licensesResp := &types.ListLicenses{}
+	err := c.executeWithRetryAuthenticate(ctx, http.MethodGet, fmt.Sprintf(api.UnityAPIInstanceTypeResourcesWithFields, api.LicenseAction, LicenseDisplayFields), nil, licensesResp)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list licenses: %w", err)
+	}
+
+	licenses := licensesResp.Licenses
+	if licenses == nil {
+		licenses = []types.License{}
+	}
+	c.licenseCache.put(licenses)
+	return licenses, nil
+}
+
+// HasFeature returns true if the license of the given feature is installed and valid
+func (c *UnityClientImpl) HasFeature(ctx context.Context, feature LicenseType) (bool, error) {
+	licenseInfo, err := c.isFeatureLicensed(ctx, feature)
+	if err != nil {
+		return false, err
+	}
+	return licenseInfo.LicenseInfoContent.IsInstalled && licenseInfo.LicenseInfoContent.IsValid, nil
+}
+
+// isFeatureLicensed - Get License information of the given feature from the license inventory.
+// A feature without license instance is reported as not installed.
+func (c *UnityClientImpl) isFeatureLicensed(ctx context.Context, featureName LicenseType) (*types.LicenseInfo, error) {
+	licenses, err := c.ListLicenses(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get license info for feature %s: %w", featureName, err)
+	}
+
+	licenseInfoResp := &types.LicenseInfo{}
+	for _, license := range licenses {
+		if license.LicenseContent.ID == string(featureName) {
+			licenseInfoResp.LicenseInfoContent.IsInstalled = license.LicenseContent.IsInstalled
+			licenseInfoResp.LicenseInfoContent.IsValid = license.LicenseContent.IsValid
+			break
+		}
+	}
+	return licenseInfoResp, nil
+}
@@ -0,0 +1,105 @@
+/*
+ Copyright © 2025 Dell Inc. or its subsidiaries. All Rights Reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package gounity
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	types "github.com/dell/gounity/apitypes"
+	mocksapi "github.com/dell/gounity/mocks/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func mockListLicenses(apiClient *mocksapi.Client) {
+	apiClient.On("DoWithHeaders", mock.Anything, "GET", "/api/types/license/instances?fields="+LicenseDisplayFields, mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(*types.ListLicenses)
+		resp.Licenses = []types.License{
+			{LicenseContent: types.LicenseContent{ID: string(ThinProvisioning), Name: "Thin Provisioning", IsInstalled: true, IsValid: true, IsPermanent: true}},
+			{LicenseContent: types.LicenseContent{ID: string(Replication), Name: "Replication", IsInstalled: true, IsValid: false, Expires: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}},
+		}
+	}).Once()
+}
+
+func TestListLicenses(t *testing.T) {
+	fmt.Println("Begin - List Licenses Test")
+	apiClient := &mocksapi.Client{}
+	client := &UnityClientImpl{api: apiClient, configConnect: &ConfigConnect{}}
+	client.SetLicenseCacheTTL(time.Minute)
+	ctx := context.Background()
+
+	mockListLicenses(apiClient)
+	licenses, err := client.ListLicenses(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, licenses, 2)
+
+	// Served from the cache, unaffected by changes to the first result
+	licenses[0].LicenseContent.IsValid = false
+	licenses, err = client.ListLicenses(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, licenses, 2)
+	assert.True(t, licenses[0].LicenseContent.IsValid)
+	licenses[0].LicenseContent.IsValid = false
+	licenses, _ = client.ListLicenses(ctx)
+	assert.True(t, licenses[0].LicenseContent.IsValid)
+	apiClient.AssertExpectations(t)
+
+	// Negative case
+	client.SetLicenseCacheTTL(0)
+	apiClient.On("DoWithHeaders", anyArgs...).Return(errors.New("list licenses failed")).Once()
+	_, err = client.ListLicenses(ctx)
+	assert.Error(t, err)
+
+	fmt.Println("List Licenses Test - Successful")
+}
+
+func TestHasFeature(t *testing.T) {
+	fmt.Println("Begin - Has Feature Test")
+	apiClient := &mocksapi.Client{}
+	client := &UnityClientImpl{api: apiClient, configConnect: &ConfigConnect{}}
+	client.SetLicenseCacheTTL(time.Minute)
+	ctx := context.Background()
+
+	// A single license lookup serves every feature
+	mockListLicenses(apiClient)
+	licensed, err := client.HasFeature(ctx, ThinProvisioning)
+	assert.NoError(t, err)
+	assert.True(t, licensed)
+
+	licensed, err = client.HasFeature(ctx, Replication)
+	assert.NoError(t, err)
+	assert.False(t, licensed)
+
+	licensed, err = client.HasFeature(ctx, ISCSI)
+	assert.NoError(t, err)
+	assert.False(t, licensed)
+	apiClient.AssertExpectations(t)
+
+	// Negative case
+	client.SetLicenseCacheTTL(0)
+	apiClient.On("DoWithHeaders", anyArgs...).Return(errors.New("list licenses failed")).Once()
+	_, err = client.HasFeature(ctx, ThinProvisioning)
+	assert.ErrorContains(t, err, "list licenses failed")
+
+	apiClient.On("DoWithHeaders", anyArgs...).Return(context.Canceled).Once()
+	_, err = client.HasFeature(ctx, ThinProvisioning)
+	assert.ErrorIs(t, err, context.Canceled)
+
+	fmt.Println("Has Feature Test - Successful")
+}
@@ -16,39 +16,161 @@ package gounity
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
+	"sort"
 	"strconv"
+	"sync"
+	"time"
 
 	"github.com/dell/gounity/api"
 	types "github.com/dell/gounity/apitypes"
 	util "github.com/dell/gounity/gounityutil"
 )
 
-// GetAllRealTimeMetricPaths gets all the Unity Metric paths. Consider using for debugging
-// or enumerating metrics. This will take a bit of time to complete.
-// - /api/types/metric/instances?compact=true&filter=isRealtimeAvailable eq true
-func (c *UnityClientImpl) GetAllRealTimeMetricPaths(ctx context.Context) error {
+// MetricsPageSize is the number of metric instances requested per page by ListMetrics.
+const MetricsPageSize = 2000
+
+// DefaultMetricsCacheTTL is the default time for which ListMetrics results are cached.
+// The metric catalog is static for a given Unity software version.
+const DefaultMetricsCacheTTL = 24 * time.Hour
+
+// metricsCacheEntry holds the cached result of a ListMetrics call
+type metricsCacheEntry struct {
+	metrics []types.MetricInstance
+	expires time.Time
+}
+
+// metricsCache caches ListMetrics results keyed by filter. It stores and returns copies so that callers cannot
+// change the cached results.
+type metricsCache struct {
+	mutex   sync.Mutex
+	ttl     time.Duration
+	entries map[string]metricsCacheEntry
+}
+
+func (m *metricsCache) get(filter string) ([]types.MetricInstance, bool) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	entry, ok := m.entries[filter]
+	if !ok || time.Now().After(entry.expires) {
+		return nil, false
+	}
+	return append([]types.MetricInstance{}, entry.metrics...), true
+}
+
+func (m *metricsCache) put(filter string, metrics []types.MetricInstance) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	if m.ttl <= 0 {
+		return
+	}
+	if m.entries == nil {
+		m.entries = make(map[string]metricsCacheEntry)
+	}
+	m.entries[filter] = metricsCacheEntry{
+		metrics: append([]types.MetricInstance{}, metrics...),
+		expires: time.Now().Add(m.ttl),
+	}
+}
+
+func (m *metricsCache) setTTL(ttl time.Duration) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.ttl = ttl
+	m.entries = nil
+}
+
+// SetMetricsCacheTTL sets the time for which ListMetrics results are cached.
+// A TTL of zero or less disables caching. Changing the TTL clears the cache.
+func (c *UnityClientImpl) SetMetricsCacheTTL(ttl time.Duration) {
+	c.metricsCache.setTTL(ttl)
+}
+
+// ListMetrics lists the Unity metric instances matching the given filter.
+// An empty filter lists every metric. Results are cached per filter for the configured TTL.
+// - Example: GET /api/types/metric/instances?fields=id,path,...&filter=isRealtimeAvailable eq true&per_page=2000&page=1
+func (c *UnityClientImpl) ListMetrics(ctx context.Context, filter string) ([]types.MetricInstance, error) {
 	log := util.GetRunIDLogger(ctx)
-	filter := "isRealtimeAvailable eq true"
 
-	query := fmt.Sprintf("%s&compact=true", url.QueryEscape(filter))
-	queryURI := fmt.Sprintf(api.UnityInstancesFilter, api.UnityMetric, query)
-	log.Info("GetAllRealTimeMetricPaths: ", queryURI)
+	if metrics, ok := c.metricsCache.get(filter); ok && !isNoCache(ctx) {
+		log.Debugf("ListMetrics: returning %d cached metrics for filter: %s", len(metrics), filter)
+		return metrics, nil
+	}
 
-	result := &types.MetricPaths{}
+	metricsURI := fmt.Sprintf(api.UnityAPIInstanceTypeResourcesWithFields, api.UnityMetric, MetricFields)
+	if filter != "" {
+		metricsURI += "&filter=" + url.QueryEscape(filter)
+	}
+
+	metrics := []types.MetricInstance{}
+	for page := 1; ; page++ {
+		queryURI := metricsURI + fmt.Sprintf("&per_page=%d&page=%d", MetricsPageSize, page)
+		log.Debug("ListMetrics: ", queryURI)
+
+		result := &types.ListMetricInstances{}
+		err := c.executeWithRetryAuthenticate(ctx, http.MethodGet, queryURI, nil, result)
+		if err != nil {
+			return nil, fmt.Errorf("unable to list metrics: %v", err)
+		}
+		metrics = append(metrics, result.Entries...)
+		if len(result.Entries) < MetricsPageSize {
+			break
+		}
+	}
+
+	c.metricsCache.put(filter, metrics)
+	return metrics, nil
+}
+
+// ListMetricValues lists the historical values of the metric with the given path, oldest first.
+// - Example: GET /api/types/metricValue/instances?filter=path EQ "sp.*.storage.pool.*.sizeUsed"&per_page=2000&page=1
+func (c *UnityClientImpl) ListMetricValues(ctx context.Context, path string) ([]types.MetricValue, error) {
+	log := util.GetRunIDLogger(ctx)
+	if path == "" {
+		return nil, errors.New("metric path cannot be empty")
+	}
+
+	valuesURI := fmt.Sprintf(api.UnityAPIInstanceTypeResources, api.UnityMetricValue) + "?filter=" + url.QueryEscape(fmt.Sprintf("path EQ \"%s\"", path))
+
+	values := []types.MetricValue{}
+	for page := 1; ; page++ {
+		queryURI := valuesURI + fmt.Sprintf("&per_page=%d&page=%d", MetricsPageSize, page)
+		log.Debug("ListMetricValues: ", queryURI)
+
+		result := &types.ListMetricValues{}
+		err := c.executeWithRetryAuthenticate(ctx, http.MethodGet, queryURI, nil, result)
+		if err != nil {
+			return nil, fmt.Errorf("unable to list values of metric %s: %v", path, err)
+		}
+		for _, entry := range result.Entries {
+			values = append(values, entry.Content)
+		}
+		if len(result.Entries) < MetricsPageSize {
+			break
+		}
+	}
+
+	sort.SliceStable(values, func(i, j int) bool {
+		return values[i].Timestamp.Before(values[j].Timestamp)
+	})
+	return values, nil
+}
+
+// GetAllRealTimeMetricPaths logs all the Unity real time metric paths. Consider using for debugging.
+// Deprecated: use ListMetrics with the filter "isRealtimeAvailable eq true" instead.
+func (c *UnityClientImpl) GetAllRealTimeMetricPaths(ctx context.Context) error {
+	log := util.GetRunIDLogger(ctx)
 
-	err := c.executeWithRetryAuthenticate(ctx, http.MethodGet, queryURI, nil, result)
+	metrics, err := c.ListMetrics(ctx, "isRealtimeAvailable eq true")
 	if err != nil {
 		return err
 	}
 
-	metricInstance := &types.MetricInstance{}
-	for _, entry := range result.Entries {
-		instanceURI := fmt.Sprintf(api.UnityAPIGetResourceURI, api.UnityMetric, strconv.Itoa(entry.Cnt.ID))
-		err = c.executeWithRetryAuthenticate(ctx, http.MethodGet, instanceURI, nil, metricInstance)
-		fmt.Printf("%d - %s - %s\n", metricInstance.Content.ID, metricInstance.Content.Path, metricInstance.Content.Description)
+	for _, metric := range metrics {
+		log.Infof("%d - %s - %s", metric.Content.ID, metric.Content.Path, metric.Content.Description)
 	}
 
 	return nil
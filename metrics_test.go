@@ -19,6 +19,7 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	types "github.com/dell/gounity/apitypes"
 	mocksapi "github.com/dell/gounity/mocks/api"
@@ -80,25 +81,19 @@ func TestGetAllRealTimeMetricPaths(t *testing.T) {
 	ctx := context.Background()
 
 	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", anyArgs...).Return(nil).Run(func(args mock.Arguments) {
-		resp := args.Get(5).(*types.MetricPaths)
-		*resp = types.MetricPaths{
-			Entries: []types.MetricEntries{
+		resp := args.Get(5).(*types.ListMetricInstances)
+		*resp = types.ListMetricInstances{
+			Entries: []types.MetricInstance{
 				{
-					Cnt: types.MetricContent{
-						ID: 12345,
+					Content: types.MetricInfo{
+						ID:                  12345,
+						Path:                "sp.*.cpu.summary.busyTicks",
+						IsRealtimeAvailable: true,
 					},
 				},
 			},
 		}
 	}).Once()
-	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", anyArgs...).Return(nil).Run(func(args mock.Arguments) {
-		resp := args.Get(5).(*types.MetricInstance)
-		*resp = types.MetricInstance{
-			Content: types.MetricInfo{
-				IsRealtimeAvailable: true,
-			},
-		}
-	}).Once()
 	err := testConf.client.GetAllRealTimeMetricPaths(ctx)
 	assert.Nil(t, err)
 
@@ -109,6 +104,56 @@ func TestGetAllRealTimeMetricPaths(t *testing.T) {
 	fmt.Println("GetAllRealTimeMetricPaths Test - Successful")
 }
 
+func TestListMetrics(t *testing.T) {
+	fmt.Println("Begin - ListMetrics Test")
+	ctx := context.Background()
+	apiClient := &mocksapi.Client{}
+	client := &UnityClientImpl{api: apiClient, configConnect: &ConfigConnect{}}
+	client.SetMetricsCacheTTL(time.Hour)
+
+	// First page is full, so a second page is requested
+	apiClient.On("DoWithHeaders", mock.Anything, "GET", fmt.Sprintf("/api/types/metric/instances?fields=%s&filter=isRealtimeAvailable+eq+true&per_page=%d&page=1", MetricFields, MetricsPageSize), mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(*types.ListMetricInstances)
+		resp.Entries = make([]types.MetricInstance, MetricsPageSize)
+		resp.Entries[0].Content = types.MetricInfo{ID: 1, Path: "sp.*.cpu.summary.busyTicks", IsRealtimeAvailable: true}
+	}).Once()
+	apiClient.On("DoWithHeaders", mock.Anything, "GET", fmt.Sprintf("/api/types/metric/instances?fields=%s&filter=isRealtimeAvailable+eq+true&per_page=%d&page=2", MetricFields, MetricsPageSize), mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(*types.ListMetricInstances)
+		resp.Entries = []types.MetricInstance{{Content: types.MetricInfo{ID: 2, Path: "sp.*.cpu.summary.idleTicks", IsRealtimeAvailable: true}}}
+	}).Once()
+
+	metrics, err := client.ListMetrics(ctx, "isRealtimeAvailable eq true")
+	assert.NoError(t, err)
+	assert.Len(t, metrics, MetricsPageSize+1)
+	assert.Equal(t, "sp.*.cpu.summary.busyTicks", metrics[0].Content.Path)
+	assert.Equal(t, "sp.*.cpu.summary.idleTicks", metrics[MetricsPageSize].Content.Path)
+
+	// Second call is served from the cache, unaffected by changes to the first result
+	metrics[0].Content.Path = "changed"
+	metrics, err = client.ListMetrics(ctx, "isRealtimeAvailable eq true")
+	assert.NoError(t, err)
+	assert.Len(t, metrics, MetricsPageSize+1)
+	assert.Equal(t, "sp.*.cpu.summary.busyTicks", metrics[0].Content.Path)
+	metrics[0].Content.Path = "changed"
+	metrics, _ = client.ListMetrics(ctx, "isRealtimeAvailable eq true")
+	assert.Equal(t, "sp.*.cpu.summary.busyTicks", metrics[0].Content.Path)
+	apiClient.AssertNumberOfCalls(t, "DoWithHeaders", 2)
+
+	// Filters are escaped in the query
+	apiClient.On("DoWithHeaders", mock.Anything, "GET", fmt.Sprintf("/api/types/metric/instances?fields=%s&filter=path+lk+%%22sp.%%2A%%22&per_page=%d&page=1", MetricFields, MetricsPageSize), mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+	metrics, err = client.ListMetrics(ctx, `path lk "sp.*"`)
+	assert.NoError(t, err)
+	assert.Empty(t, metrics)
+
+	// Negative case: disabling the cache forces a new request which fails
+	client.SetMetricsCacheTTL(0)
+	apiClient.On("DoWithHeaders", anyArgs...).Return(errors.New("list metrics failed")).Once()
+	_, err = client.ListMetrics(ctx, "isRealtimeAvailable eq true")
+	assert.Error(t, err)
+
+	fmt.Println("ListMetrics Test - Successful")
+}
+
 func TestCreateRealTimeMetricsQuery(t *testing.T) {
 	fmt.Println("Begin - CreateRealTimeMetricsQuery Test")
 	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).ExpectedCalls = nil
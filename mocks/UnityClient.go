@@ -4,6 +4,7 @@ package mocks
 
 import (
 	context "context"
+	time "time"
 
 	gounity "github.com/dell/gounity"
 
@@ -17,6 +18,54 @@ type UnityClient struct {
 	mock.Mock
 }
 
+// AcknowledgeAlert provides a mock function with given fields: ctx, alertID
+func (_m *UnityClient) AcknowledgeAlert(ctx context.Context, alertID string) error {
+	ret := _m.Called(ctx, alertID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AcknowledgeAlert")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, alertID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AuditOrphans provides a mock function with given fields: ctx, opts
+func (_m *UnityClient) AuditOrphans(ctx context.Context, opts gounity.AuditOptions) (*gounity.AuditReport, error) {
+	ret := _m.Called(ctx, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AuditOrphans")
+	}
+
+	var r0 *gounity.AuditReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, gounity.AuditOptions) (*gounity.AuditReport, error)); ok {
+		return rf(ctx, opts)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, gounity.AuditOptions) *gounity.AuditReport); ok {
+		r0 = rf(ctx, opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gounity.AuditReport)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, gounity.AuditOptions) error); ok {
+		r1 = rf(ctx, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Authenticate provides a mock function with given fields: ctx, configConnect
 func (_m *UnityClient) Authenticate(ctx context.Context, configConnect *gounity.ConfigConnect) error {
 	ret := _m.Called(ctx, configConnect)
@@ -53,6 +102,84 @@ func (_m *UnityClient) BasicSystemInfo(ctx context.Context, configConnect *gouni
 	return r0
 }
 
+// BuildDependencyGraph provides a mock function with given fields: ctx
+func (_m *UnityClient) BuildDependencyGraph(ctx context.Context) (*gounity.DependencyGraph, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BuildDependencyGraph")
+	}
+
+	var r0 *gounity.DependencyGraph
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*gounity.DependencyGraph, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *gounity.DependencyGraph); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gounity.DependencyGraph)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Close provides a mock function with no fields
+func (_m *UnityClient) Close() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Close")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CollectMarkedResources provides a mock function with given fields: ctx, opts
+func (_m *UnityClient) CollectMarkedResources(ctx context.Context, opts gounity.CollectOptions) (*gounity.CollectReport, error) {
+	ret := _m.Called(ctx, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CollectMarkedResources")
+	}
+
+	var r0 *gounity.CollectReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, gounity.CollectOptions) (*gounity.CollectReport, error)); ok {
+		return rf(ctx, opts)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, gounity.CollectOptions) *gounity.CollectReport); ok {
+		r0 = rf(ctx, opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gounity.CollectReport)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, gounity.CollectOptions) error); ok {
+		r1 = rf(ctx, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CopySnapshot provides a mock function with given fields: ctx, sourceSnapshotID, name
 func (_m *UnityClient) CopySnapshot(ctx context.Context, sourceSnapshotID string, name string) (*types.Snapshot, error) {
 	ret := _m.Called(ctx, sourceSnapshotID, name)
@@ -383,6 +510,36 @@ func (_m *UnityClient) CreateSnapshot(ctx context.Context, storageResourceID str
 	return r0, r1
 }
 
+// CreateSnapshotSchedule provides a mock function with given fields: ctx, name, rules
+func (_m *UnityClient) CreateSnapshotSchedule(ctx context.Context, name string, rules []types.SnapScheduleRule) (*types.SnapSchedule, error) {
+	ret := _m.Called(ctx, name, rules)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSnapshotSchedule")
+	}
+
+	var r0 *types.SnapSchedule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []types.SnapScheduleRule) (*types.SnapSchedule, error)); ok {
+		return rf(ctx, name, rules)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, []types.SnapScheduleRule) *types.SnapSchedule); ok {
+		r0 = rf(ctx, name, rules)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.SnapSchedule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, []types.SnapScheduleRule) error); ok {
+		r1 = rf(ctx, name, rules)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateSnapshotWithFsAccesType provides a mock function with given fields: ctx, storageResourceID, snapshotName, _a3, retentionDuration, filesystemAccessType
 func (_m *UnityClient) CreateSnapshotWithFsAccesType(ctx context.Context, storageResourceID string, snapshotName string, _a3 string, retentionDuration string, filesystemAccessType gounity.FilesystemAccessType) (*types.Snapshot, error) {
 	ret := _m.Called(ctx, storageResourceID, snapshotName, _a3, retentionDuration, filesystemAccessType)
@@ -413,6 +570,36 @@ func (_m *UnityClient) CreateSnapshotWithFsAccesType(ctx context.Context, storag
 	return r0, r1
 }
 
+// CreateStoragePool provides a mock function with given fields: ctx, name, description, raidGroups
+func (_m *UnityClient) CreateStoragePool(ctx context.Context, name string, description string, raidGroups []types.RaidGroupParameters) (*types.StoragePool, error) {
+	ret := _m.Called(ctx, name, description, raidGroups)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateStoragePool")
+	}
+
+	var r0 *types.StoragePool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, []types.RaidGroupParameters) (*types.StoragePool, error)); ok {
+		return rf(ctx, name, description, raidGroups)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, []types.RaidGroupParameters) *types.StoragePool); ok {
+		r0 = rf(ctx, name, description, raidGroups)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.StoragePool)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, []types.RaidGroupParameters) error); ok {
+		r1 = rf(ctx, name, description, raidGroups)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreteLunThinClone provides a mock function with given fields: ctx, name, snapID, volID
 func (_m *UnityClient) CreteLunThinClone(ctx context.Context, name string, snapID string, volID string) (*types.Volume, error) {
 	ret := _m.Called(ctx, name, snapID, volID)
@@ -443,6 +630,24 @@ func (_m *UnityClient) CreteLunThinClone(ctx context.Context, name string, snapI
 	return r0, r1
 }
 
+// DeleteAlert provides a mock function with given fields: ctx, alertID
+func (_m *UnityClient) DeleteAlert(ctx context.Context, alertID string) error {
+	ret := _m.Called(ctx, alertID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAlert")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, alertID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeleteFilesystem provides a mock function with given fields: ctx, filesystemID
 func (_m *UnityClient) DeleteFilesystem(ctx context.Context, filesystemID string) error {
 	ret := _m.Called(ctx, filesystemID)
@@ -497,6 +702,24 @@ func (_m *UnityClient) DeleteHost(ctx context.Context, hostName string) error {
 	return r0
 }
 
+// DeleteHostInitiator provides a mock function with given fields: ctx, initiatorID
+func (_m *UnityClient) DeleteHostInitiator(ctx context.Context, initiatorID string) error {
+	ret := _m.Called(ctx, initiatorID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteHostInitiator")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, initiatorID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeleteNFSShare provides a mock function with given fields: ctx, filesystemID, nfsShareID
 func (_m *UnityClient) DeleteNFSShare(ctx context.Context, filesystemID string, nfsShareID string) error {
 	ret := _m.Called(ctx, filesystemID, nfsShareID)
@@ -569,17 +792,17 @@ func (_m *UnityClient) DeleteSnapshot(ctx context.Context, snapshotID string) er
 	return r0
 }
 
-// DeleteVolume provides a mock function with given fields: ctx, volumeID
-func (_m *UnityClient) DeleteVolume(ctx context.Context, volumeID string) error {
-	ret := _m.Called(ctx, volumeID)
+// DeleteSnapshotSchedule provides a mock function with given fields: ctx, scheduleID
+func (_m *UnityClient) DeleteSnapshotSchedule(ctx context.Context, scheduleID string) error {
+	ret := _m.Called(ctx, scheduleID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteVolume")
+		panic("no return value specified for DeleteSnapshotSchedule")
 	}
 
 	var r0 error
 	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
-		r0 = rf(ctx, volumeID)
+		r0 = rf(ctx, scheduleID)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -587,17 +810,17 @@ func (_m *UnityClient) DeleteVolume(ctx context.Context, volumeID string) error
 	return r0
 }
 
-// ExpandFilesystem provides a mock function with given fields: ctx, filesystemID, newSize
-func (_m *UnityClient) ExpandFilesystem(ctx context.Context, filesystemID string, newSize uint64) error {
-	ret := _m.Called(ctx, filesystemID, newSize)
+// DeleteStoragePool provides a mock function with given fields: ctx, poolID
+func (_m *UnityClient) DeleteStoragePool(ctx context.Context, poolID string) error {
+	ret := _m.Called(ctx, poolID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ExpandFilesystem")
+		panic("no return value specified for DeleteStoragePool")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, uint64) error); ok {
-		r0 = rf(ctx, filesystemID, newSize)
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, poolID)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -605,17 +828,17 @@ func (_m *UnityClient) ExpandFilesystem(ctx context.Context, filesystemID string
 	return r0
 }
 
-// ExpandVolume provides a mock function with given fields: ctx, volumeID, newSize
-func (_m *UnityClient) ExpandVolume(ctx context.Context, volumeID string, newSize uint64) error {
-	ret := _m.Called(ctx, volumeID, newSize)
+// DeleteVolume provides a mock function with given fields: ctx, volumeID
+func (_m *UnityClient) DeleteVolume(ctx context.Context, volumeID string) error {
+	ret := _m.Called(ctx, volumeID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ExpandVolume")
+		panic("no return value specified for DeleteVolume")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, uint64) error); ok {
-		r0 = rf(ctx, volumeID, newSize)
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, volumeID)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -623,47 +846,59 @@ func (_m *UnityClient) ExpandVolume(ctx context.Context, volumeID string, newSiz
 	return r0
 }
 
-// ExportVolume provides a mock function with given fields: ctx, volID, hostID
-func (_m *UnityClient) ExportVolume(ctx context.Context, volID string, hostID string) error {
-	ret := _m.Called(ctx, volID, hostID)
+// EnsureFilesystem provides a mock function with given fields: ctx, name, storagepool, description, nasServer, size, tieringPolicy, hostIOSize, supportedProtocol, isThinEnabled, isDataReductionEnabled
+func (_m *UnityClient) EnsureFilesystem(ctx context.Context, name string, storagepool string, description string, nasServer string, size uint64, tieringPolicy int, hostIOSize int, supportedProtocol int, isThinEnabled bool, isDataReductionEnabled bool) (*types.Filesystem, error) {
+	ret := _m.Called(ctx, name, storagepool, description, nasServer, size, tieringPolicy, hostIOSize, supportedProtocol, isThinEnabled, isDataReductionEnabled)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ExportVolume")
+		panic("no return value specified for EnsureFilesystem")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
-		r0 = rf(ctx, volID, hostID)
+	var r0 *types.Filesystem
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string, uint64, int, int, int, bool, bool) (*types.Filesystem, error)); ok {
+		return rf(ctx, name, storagepool, description, nasServer, size, tieringPolicy, hostIOSize, supportedProtocol, isThinEnabled, isDataReductionEnabled)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string, uint64, int, int, int, bool, bool) *types.Filesystem); ok {
+		r0 = rf(ctx, name, storagepool, description, nasServer, size, tieringPolicy, hostIOSize, supportedProtocol, isThinEnabled, isDataReductionEnabled)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.Filesystem)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, string, uint64, int, int, int, bool, bool) error); ok {
+		r1 = rf(ctx, name, storagepool, description, nasServer, size, tieringPolicy, hostIOSize, supportedProtocol, isThinEnabled, isDataReductionEnabled)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// FindFcPortByID provides a mock function with given fields: ctx, fcPortID
-func (_m *UnityClient) FindFcPortByID(ctx context.Context, fcPortID string) (*types.FcPort, error) {
-	ret := _m.Called(ctx, fcPortID)
+// EnsureHost provides a mock function with given fields: ctx, hostName, tenantID
+func (_m *UnityClient) EnsureHost(ctx context.Context, hostName string, tenantID string) (*types.Host, error) {
+	ret := _m.Called(ctx, hostName, tenantID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for FindFcPortByID")
+		panic("no return value specified for EnsureHost")
 	}
 
-	var r0 *types.FcPort
+	var r0 *types.Host
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (*types.FcPort, error)); ok {
-		return rf(ctx, fcPortID)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*types.Host, error)); ok {
+		return rf(ctx, hostName, tenantID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) *types.FcPort); ok {
-		r0 = rf(ctx, fcPortID)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *types.Host); ok {
+		r0 = rf(ctx, hostName, tenantID)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*types.FcPort)
+			r0 = ret.Get(0).(*types.Host)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, fcPortID)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, hostName, tenantID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -671,29 +906,29 @@ func (_m *UnityClient) FindFcPortByID(ctx context.Context, fcPortID string) (*ty
 	return r0, r1
 }
 
-// FindFilesystemByID provides a mock function with given fields: ctx, filesystemID
-func (_m *UnityClient) FindFilesystemByID(ctx context.Context, filesystemID string) (*types.Filesystem, error) {
-	ret := _m.Called(ctx, filesystemID)
+// EnsureNFSShare provides a mock function with given fields: ctx, name, path, filesystemID, nfsShareDefaultAccess
+func (_m *UnityClient) EnsureNFSShare(ctx context.Context, name string, path string, filesystemID string, nfsShareDefaultAccess gounity.NFSShareDefaultAccess) (*types.NFSShare, error) {
+	ret := _m.Called(ctx, name, path, filesystemID, nfsShareDefaultAccess)
 
 	if len(ret) == 0 {
-		panic("no return value specified for FindFilesystemByID")
+		panic("no return value specified for EnsureNFSShare")
 	}
 
-	var r0 *types.Filesystem
+	var r0 *types.NFSShare
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (*types.Filesystem, error)); ok {
-		return rf(ctx, filesystemID)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, gounity.NFSShareDefaultAccess) (*types.NFSShare, error)); ok {
+		return rf(ctx, name, path, filesystemID, nfsShareDefaultAccess)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) *types.Filesystem); ok {
-		r0 = rf(ctx, filesystemID)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, gounity.NFSShareDefaultAccess) *types.NFSShare); ok {
+		r0 = rf(ctx, name, path, filesystemID, nfsShareDefaultAccess)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*types.Filesystem)
+			r0 = ret.Get(0).(*types.NFSShare)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, filesystemID)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, gounity.NFSShareDefaultAccess) error); ok {
+		r1 = rf(ctx, name, path, filesystemID, nfsShareDefaultAccess)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -701,18 +936,210 @@ func (_m *UnityClient) FindFilesystemByID(ctx context.Context, filesystemID stri
 	return r0, r1
 }
 
-// FindFilesystemByName provides a mock function with given fields: ctx, filesystemName
-func (_m *UnityClient) FindFilesystemByName(ctx context.Context, filesystemName string) (*types.Filesystem, error) {
-	ret := _m.Called(ctx, filesystemName)
+// EnsureSnapshot provides a mock function with given fields: ctx, storageResourceID, snapshotName, description, retentionDuration
+func (_m *UnityClient) EnsureSnapshot(ctx context.Context, storageResourceID string, snapshotName string, description string, retentionDuration string) (*types.Snapshot, error) {
+	ret := _m.Called(ctx, storageResourceID, snapshotName, description, retentionDuration)
 
 	if len(ret) == 0 {
-		panic("no return value specified for FindFilesystemByName")
+		panic("no return value specified for EnsureSnapshot")
 	}
 
-	var r0 *types.Filesystem
+	var r0 *types.Snapshot
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (*types.Filesystem, error)); ok {
-		return rf(ctx, filesystemName)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string) (*types.Snapshot, error)); ok {
+		return rf(ctx, storageResourceID, snapshotName, description, retentionDuration)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string) *types.Snapshot); ok {
+		r0 = rf(ctx, storageResourceID, snapshotName, description, retentionDuration)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.Snapshot)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, string) error); ok {
+		r1 = rf(ctx, storageResourceID, snapshotName, description, retentionDuration)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EnsureVolume provides a mock function with given fields: ctx, name, poolID, description, size, fastVPTieringPolicy, hostIOLimitID, isThinEnabled, isDataReductionEnabled
+func (_m *UnityClient) EnsureVolume(ctx context.Context, name string, poolID string, description string, size uint64, fastVPTieringPolicy int, hostIOLimitID string, isThinEnabled bool, isDataReductionEnabled bool) (*types.Volume, error) {
+	ret := _m.Called(ctx, name, poolID, description, size, fastVPTieringPolicy, hostIOLimitID, isThinEnabled, isDataReductionEnabled)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EnsureVolume")
+	}
+
+	var r0 *types.Volume
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, uint64, int, string, bool, bool) (*types.Volume, error)); ok {
+		return rf(ctx, name, poolID, description, size, fastVPTieringPolicy, hostIOLimitID, isThinEnabled, isDataReductionEnabled)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, uint64, int, string, bool, bool) *types.Volume); ok {
+		r0 = rf(ctx, name, poolID, description, size, fastVPTieringPolicy, hostIOLimitID, isThinEnabled, isDataReductionEnabled)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.Volume)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, uint64, int, string, bool, bool) error); ok {
+		r1 = rf(ctx, name, poolID, description, size, fastVPTieringPolicy, hostIOLimitID, isThinEnabled, isDataReductionEnabled)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExpandFilesystem provides a mock function with given fields: ctx, filesystemID, newSize
+func (_m *UnityClient) ExpandFilesystem(ctx context.Context, filesystemID string, newSize uint64) error {
+	ret := _m.Called(ctx, filesystemID, newSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExpandFilesystem")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, uint64) error); ok {
+		r0 = rf(ctx, filesystemID, newSize)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ExpandStoragePool provides a mock function with given fields: ctx, poolID, raidGroups
+func (_m *UnityClient) ExpandStoragePool(ctx context.Context, poolID string, raidGroups []types.RaidGroupParameters) error {
+	ret := _m.Called(ctx, poolID, raidGroups)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExpandStoragePool")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []types.RaidGroupParameters) error); ok {
+		r0 = rf(ctx, poolID, raidGroups)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ExpandVolume provides a mock function with given fields: ctx, volumeID, newSize
+func (_m *UnityClient) ExpandVolume(ctx context.Context, volumeID string, newSize uint64) error {
+	ret := _m.Called(ctx, volumeID, newSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExpandVolume")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, uint64) error); ok {
+		r0 = rf(ctx, volumeID, newSize)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ExportVolume provides a mock function with given fields: ctx, volID, hostID
+func (_m *UnityClient) ExportVolume(ctx context.Context, volID string, hostID string) error {
+	ret := _m.Called(ctx, volID, hostID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportVolume")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, volID, hostID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FindFcPortByID provides a mock function with given fields: ctx, fcPortID
+func (_m *UnityClient) FindFcPortByID(ctx context.Context, fcPortID string) (*types.FcPort, error) {
+	ret := _m.Called(ctx, fcPortID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindFcPortByID")
+	}
+
+	var r0 *types.FcPort
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*types.FcPort, error)); ok {
+		return rf(ctx, fcPortID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *types.FcPort); ok {
+		r0 = rf(ctx, fcPortID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.FcPort)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, fcPortID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindFilesystemByID provides a mock function with given fields: ctx, filesystemID
+func (_m *UnityClient) FindFilesystemByID(ctx context.Context, filesystemID string) (*types.Filesystem, error) {
+	ret := _m.Called(ctx, filesystemID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindFilesystemByID")
+	}
+
+	var r0 *types.Filesystem
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*types.Filesystem, error)); ok {
+		return rf(ctx, filesystemID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *types.Filesystem); ok {
+		r0 = rf(ctx, filesystemID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.Filesystem)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, filesystemID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindFilesystemByName provides a mock function with given fields: ctx, filesystemName
+func (_m *UnityClient) FindFilesystemByName(ctx context.Context, filesystemName string) (*types.Filesystem, error) {
+	ret := _m.Called(ctx, filesystemName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindFilesystemByName")
+	}
+
+	var r0 *types.Filesystem
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*types.Filesystem, error)); ok {
+		return rf(ctx, filesystemName)
 	}
 	if rf, ok := ret.Get(0).(func(context.Context, string) *types.Filesystem); ok {
 		r0 = rf(ctx, filesystemName)
@@ -1121,6 +1548,36 @@ func (_m *UnityClient) FindStoragePoolByName(ctx context.Context, poolName strin
 	return r0, r1
 }
 
+// FindStorageResourceByID provides a mock function with given fields: ctx, resourceID
+func (_m *UnityClient) FindStorageResourceByID(ctx context.Context, resourceID string) (*types.StorageResourceParameters, error) {
+	ret := _m.Called(ctx, resourceID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindStorageResourceByID")
+	}
+
+	var r0 *types.StorageResourceParameters
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*types.StorageResourceParameters, error)); ok {
+		return rf(ctx, resourceID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *types.StorageResourceParameters); ok {
+		r0 = rf(ctx, resourceID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.StorageResourceParameters)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, resourceID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // FindTenants provides a mock function with given fields: ctx
 func (_m *UnityClient) FindTenants(ctx context.Context) (*types.TenantInfo, error) {
 	ret := _m.Called(ctx)
@@ -1259,6 +1716,56 @@ func (_m *UnityClient) GetAllRealTimeMetricPaths(ctx context.Context) error {
 	return r0
 }
 
+// GetBasicSystemInfo provides a mock function with given fields: ctx
+func (_m *UnityClient) GetBasicSystemInfo(ctx context.Context) (*types.Content, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBasicSystemInfo")
+	}
+
+	var r0 *types.Content
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*types.Content, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *types.Content); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.Content)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCapabilities provides a mock function with no fields
+func (_m *UnityClient) GetCapabilities() *gounity.Capabilities {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCapabilities")
+	}
+
+	var r0 *gounity.Capabilities
+	if rf, ok := ret.Get(0).(func() *gounity.Capabilities); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gounity.Capabilities)
+		}
+	}
+
+	return r0
+}
+
 // GetCapacity provides a mock function with given fields: ctx
 func (_m *UnityClient) GetCapacity(ctx context.Context) (*types.SystemCapacityMetricsQueryResult, error) {
 	ret := _m.Called(ctx)
@@ -1317,29 +1824,59 @@ func (_m *UnityClient) GetFilesystemIDFromResID(ctx context.Context, filesystemR
 	return r0, r1
 }
 
-// GetMaxVolumeSize provides a mock function with given fields: ctx, systemLimitID
-func (_m *UnityClient) GetMaxVolumeSize(ctx context.Context, systemLimitID string) (*types.MaxVolumSizeInfo, error) {
-	ret := _m.Called(ctx, systemLimitID)
+// GetHardwareInventory provides a mock function with given fields: ctx
+func (_m *UnityClient) GetHardwareInventory(ctx context.Context) (*gounity.HardwareInventory, error) {
+	ret := _m.Called(ctx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetMaxVolumeSize")
+		panic("no return value specified for GetHardwareInventory")
 	}
 
-	var r0 *types.MaxVolumSizeInfo
+	var r0 *gounity.HardwareInventory
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (*types.MaxVolumSizeInfo, error)); ok {
-		return rf(ctx, systemLimitID)
+	if rf, ok := ret.Get(0).(func(context.Context) (*gounity.HardwareInventory, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) *types.MaxVolumSizeInfo); ok {
-		r0 = rf(ctx, systemLimitID)
+	if rf, ok := ret.Get(0).(func(context.Context) *gounity.HardwareInventory); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*types.MaxVolumSizeInfo)
+			r0 = ret.Get(0).(*gounity.HardwareInventory)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, systemLimitID)
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetMaxVolumeSize provides a mock function with given fields: ctx, systemLimitID
+func (_m *UnityClient) GetMaxVolumeSize(ctx context.Context, systemLimitID string) (*types.MaxVolumSizeInfo, error) {
+	ret := _m.Called(ctx, systemLimitID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMaxVolumeSize")
+	}
+
+	var r0 *types.MaxVolumSizeInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*types.MaxVolumSizeInfo, error)); ok {
+		return rf(ctx, systemLimitID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *types.MaxVolumSizeInfo); ok {
+		r0 = rf(ctx, systemLimitID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.MaxVolumSizeInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, systemLimitID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1377,6 +1914,96 @@ func (_m *UnityClient) GetMetricsCollection(ctx context.Context, queryID int) (*
 	return r0, r1
 }
 
+// GetSessionInfo provides a mock function with given fields: ctx
+func (_m *UnityClient) GetSessionInfo(ctx context.Context) (*gounity.SessionInfo, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSessionInfo")
+	}
+
+	var r0 *gounity.SessionInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*gounity.SessionInfo, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *gounity.SessionInfo); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gounity.SessionInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetSystemHealthReport provides a mock function with given fields: ctx
+func (_m *UnityClient) GetSystemHealthReport(ctx context.Context) (*gounity.SystemHealthReport, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSystemHealthReport")
+	}
+
+	var r0 *gounity.SystemHealthReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*gounity.SystemHealthReport, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *gounity.SystemHealthReport); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gounity.SystemHealthReport)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetSystemInfo provides a mock function with given fields: ctx
+func (_m *UnityClient) GetSystemInfo(ctx context.Context) (*gounity.SystemInfo, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSystemInfo")
+	}
+
+	var r0 *gounity.SystemInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*gounity.SystemInfo, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *gounity.SystemInfo); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gounity.SystemInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetToken provides a mock function with no fields
 func (_m *UnityClient) GetToken() string {
 	ret := _m.Called()
@@ -1395,6 +2022,154 @@ func (_m *UnityClient) GetToken() string {
 	return r0
 }
 
+// HasFeature provides a mock function with given fields: ctx, feature
+func (_m *UnityClient) HasFeature(ctx context.Context, feature gounity.LicenseType) (bool, error) {
+	ret := _m.Called(ctx, feature)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HasFeature")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, gounity.LicenseType) (bool, error)); ok {
+		return rf(ctx, feature)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, gounity.LicenseType) bool); ok {
+		r0 = rf(ctx, feature)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, gounity.LicenseType) error); ok {
+		r1 = rf(ctx, feature)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListAlerts provides a mock function with given fields: ctx, minSeverity, since
+func (_m *UnityClient) ListAlerts(ctx context.Context, minSeverity gounity.AlertSeverity, since time.Time) ([]types.Alert, error) {
+	ret := _m.Called(ctx, minSeverity, since)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListAlerts")
+	}
+
+	var r0 []types.Alert
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, gounity.AlertSeverity, time.Time) ([]types.Alert, error)); ok {
+		return rf(ctx, minSeverity, since)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, gounity.AlertSeverity, time.Time) []types.Alert); ok {
+		r0 = rf(ctx, minSeverity, since)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.Alert)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, gounity.AlertSeverity, time.Time) error); ok {
+		r1 = rf(ctx, minSeverity, since)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListDiskGroups provides a mock function with given fields: ctx
+func (_m *UnityClient) ListDiskGroups(ctx context.Context) ([]types.DiskGroup, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListDiskGroups")
+	}
+
+	var r0 []types.DiskGroup
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]types.DiskGroup, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []types.DiskGroup); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.DiskGroup)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListEvents provides a mock function with given fields: ctx, minSeverity, since
+func (_m *UnityClient) ListEvents(ctx context.Context, minSeverity gounity.AlertSeverity, since time.Time) ([]types.Event, error) {
+	ret := _m.Called(ctx, minSeverity, since)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListEvents")
+	}
+
+	var r0 []types.Event
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, gounity.AlertSeverity, time.Time) ([]types.Event, error)); ok {
+		return rf(ctx, minSeverity, since)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, gounity.AlertSeverity, time.Time) []types.Event); ok {
+		r0 = rf(ctx, minSeverity, since)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.Event)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, gounity.AlertSeverity, time.Time) error); ok {
+		r1 = rf(ctx, minSeverity, since)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListFilesystems provides a mock function with given fields: ctx
+func (_m *UnityClient) ListFilesystems(ctx context.Context) ([]types.Filesystem, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListFilesystems")
+	}
+
+	var r0 []types.Filesystem
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]types.Filesystem, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []types.Filesystem); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.Filesystem)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListHostInitiators provides a mock function with given fields: ctx
 func (_m *UnityClient) ListHostInitiators(ctx context.Context) ([]types.HostInitiator, error) {
 	ret := _m.Called(ctx)
@@ -1425,6 +2200,36 @@ func (_m *UnityClient) ListHostInitiators(ctx context.Context) ([]types.HostInit
 	return r0, r1
 }
 
+// ListHosts provides a mock function with given fields: ctx
+func (_m *UnityClient) ListHosts(ctx context.Context) ([]types.Host, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListHosts")
+	}
+
+	var r0 []types.Host
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]types.Host, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []types.Host); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.Host)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListIscsiIPInterfaces provides a mock function with given fields: ctx
 func (_m *UnityClient) ListIscsiIPInterfaces(ctx context.Context) ([]types.IPInterfaceEntries, error) {
 	ret := _m.Called(ctx)
@@ -1455,6 +2260,186 @@ func (_m *UnityClient) ListIscsiIPInterfaces(ctx context.Context) ([]types.IPInt
 	return r0, r1
 }
 
+// ListLicenses provides a mock function with given fields: ctx
+func (_m *UnityClient) ListLicenses(ctx context.Context) ([]types.License, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListLicenses")
+	}
+
+	var r0 []types.License
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]types.License, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []types.License); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.License)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListMetricValues provides a mock function with given fields: ctx, path
+func (_m *UnityClient) ListMetricValues(ctx context.Context, path string) ([]types.MetricValue, error) {
+	ret := _m.Called(ctx, path)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListMetricValues")
+	}
+
+	var r0 []types.MetricValue
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]types.MetricValue, error)); ok {
+		return rf(ctx, path)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []types.MetricValue); ok {
+		r0 = rf(ctx, path)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.MetricValue)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, path)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListMetrics provides a mock function with given fields: ctx, filter
+func (_m *UnityClient) ListMetrics(ctx context.Context, filter string) ([]types.MetricInstance, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListMetrics")
+	}
+
+	var r0 []types.MetricInstance
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]types.MetricInstance, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []types.MetricInstance); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.MetricInstance)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListPoolUnits provides a mock function with given fields: ctx
+func (_m *UnityClient) ListPoolUnits(ctx context.Context) ([]types.PoolUnit, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListPoolUnits")
+	}
+
+	var r0 []types.PoolUnit
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]types.PoolUnit, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []types.PoolUnit); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.PoolUnit)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListReplicationSessions provides a mock function with given fields: ctx
+func (_m *UnityClient) ListReplicationSessions(ctx context.Context) ([]types.ReplicationSession, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListReplicationSessions")
+	}
+
+	var r0 []types.ReplicationSession
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]types.ReplicationSession, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []types.ReplicationSession); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.ReplicationSession)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListSnapshotSchedules provides a mock function with given fields: ctx
+func (_m *UnityClient) ListSnapshotSchedules(ctx context.Context) ([]types.SnapSchedule, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSnapshotSchedules")
+	}
+
+	var r0 []types.SnapSchedule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]types.SnapSchedule, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []types.SnapSchedule); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.SnapSchedule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListSnapshots provides a mock function with given fields: ctx, startToken, maxEntries, sourceVolumeID, snapshotID
 func (_m *UnityClient) ListSnapshots(ctx context.Context, startToken int, maxEntries int, sourceVolumeID string, snapshotID string) ([]types.Snapshot, int, error) {
 	ret := _m.Called(ctx, startToken, maxEntries, sourceVolumeID, snapshotID)
@@ -1492,6 +2477,36 @@ func (_m *UnityClient) ListSnapshots(ctx context.Context, startToken int, maxEnt
 	return r0, r1, r2
 }
 
+// ListStoragePools provides a mock function with given fields: ctx
+func (_m *UnityClient) ListStoragePools(ctx context.Context) ([]types.StoragePool, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListStoragePools")
+	}
+
+	var r0 []types.StoragePool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]types.StoragePool, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []types.StoragePool); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.StoragePool)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListVolumes provides a mock function with given fields: ctx, startToken, maxEntries
 func (_m *UnityClient) ListVolumes(ctx context.Context, startToken int, maxEntries int) ([]types.Volume, int, error) {
 	ret := _m.Called(ctx, startToken, maxEntries)
@@ -1529,6 +2544,42 @@ func (_m *UnityClient) ListVolumes(ctx context.Context, startToken int, maxEntri
 	return r0, r1, r2
 }
 
+// Logout provides a mock function with given fields: ctx
+func (_m *UnityClient) Logout(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Logout")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ModifyFilesystem provides a mock function with given fields: ctx, filesystemID, params
+func (_m *UnityClient) ModifyFilesystem(ctx context.Context, filesystemID string, params types.FsModifyParameters) error {
+	ret := _m.Called(ctx, filesystemID, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ModifyFilesystem")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, types.FsModifyParameters) error); ok {
+		r0 = rf(ctx, filesystemID, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // ModifyHostInitiator provides a mock function with given fields: ctx, hostID, initiator
 func (_m *UnityClient) ModifyHostInitiator(ctx context.Context, hostID string, initiator *types.HostInitiator) (*types.HostInitiator, error) {
 	ret := _m.Called(ctx, hostID, initiator)
@@ -1661,6 +2712,42 @@ func (_m *UnityClient) ModifySnapshotAutoDeleteParameter(ctx context.Context, sn
 	return r0
 }
 
+// ModifyStoragePool provides a mock function with given fields: ctx, poolID, params
+func (_m *UnityClient) ModifyStoragePool(ctx context.Context, poolID string, params types.PoolModifyParam) error {
+	ret := _m.Called(ctx, poolID, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ModifyStoragePool")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, types.PoolModifyParam) error); ok {
+		r0 = rf(ctx, poolID, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ModifyVolume provides a mock function with given fields: ctx, volID, params
+func (_m *UnityClient) ModifyVolume(ctx context.Context, volID string, params types.VolumeModifyParam) error {
+	ret := _m.Called(ctx, volID, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ModifyVolume")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, types.VolumeModifyParam) error); ok {
+		r0 = rf(ctx, volID, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // ModifyVolumeExport provides a mock function with given fields: ctx, volID, hostIDList
 func (_m *UnityClient) ModifyVolumeExport(ctx context.Context, volID string, hostIDList []string) error {
 	ret := _m.Called(ctx, volID, hostIDList)
@@ -1679,6 +2766,36 @@ func (_m *UnityClient) ModifyVolumeExport(ctx context.Context, volID string, hos
 	return r0
 }
 
+// PlanDelete provides a mock function with given fields: ctx, resourceID
+func (_m *UnityClient) PlanDelete(ctx context.Context, resourceID string) (*gounity.DeletePlan, error) {
+	ret := _m.Called(ctx, resourceID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PlanDelete")
+	}
+
+	var r0 *gounity.DeletePlan
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*gounity.DeletePlan, error)); ok {
+		return rf(ctx, resourceID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *gounity.DeletePlan); ok {
+		r0 = rf(ctx, resourceID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gounity.DeletePlan)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, resourceID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // RenameVolume provides a mock function with given fields: ctx, newName, volID
 func (_m *UnityClient) RenameVolume(ctx context.Context, newName string, volID string) error {
 	ret := _m.Called(ctx, newName, volID)
@@ -1697,11 +2814,49 @@ func (_m *UnityClient) RenameVolume(ctx context.Context, newName string, volID s
 	return r0
 }
 
+// SetLicenseCacheTTL provides a mock function with given fields: ttl
+func (_m *UnityClient) SetLicenseCacheTTL(ttl time.Duration) {
+	_m.Called(ttl)
+}
+
+// SetMetricsCacheTTL provides a mock function with given fields: ttl
+func (_m *UnityClient) SetMetricsCacheTTL(ttl time.Duration) {
+	_m.Called(ttl)
+}
+
+// SetResourceCacheTTL provides a mock function with given fields: ttl
+func (_m *UnityClient) SetResourceCacheTTL(ttl time.Duration) {
+	_m.Called(ttl)
+}
+
 // SetToken provides a mock function with given fields: token
 func (_m *UnityClient) SetToken(token string) {
 	_m.Called(token)
 }
 
+// StartSessionKeepAlive provides a mock function with given fields: ctx
+func (_m *UnityClient) StartSessionKeepAlive(ctx context.Context) {
+	_m.Called(ctx)
+}
+
+// Supports provides a mock function with given fields: feature
+func (_m *UnityClient) Supports(feature gounity.Feature) bool {
+	ret := _m.Called(feature)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Supports")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(gounity.Feature) bool); ok {
+		r0 = rf(feature)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // UnexportVolume provides a mock function with given fields: ctx, volID
 func (_m *UnityClient) UnexportVolume(ctx context.Context, volID string) error {
 	ret := _m.Called(ctx, volID)
@@ -1720,6 +2875,26 @@ func (_m *UnityClient) UnexportVolume(ctx context.Context, volID string) error {
 	return r0
 }
 
+// WatchAlerts provides a mock function with given fields: ctx, since, interval
+func (_m *UnityClient) WatchAlerts(ctx context.Context, since time.Time, interval time.Duration) <-chan types.Alert {
+	ret := _m.Called(ctx, since, interval)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WatchAlerts")
+	}
+
+	var r0 <-chan types.Alert
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Duration) <-chan types.Alert); ok {
+		r0 = rf(ctx, since, interval)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan types.Alert)
+		}
+	}
+
+	return r0
+}
+
 // NewUnityClient creates a new instance of UnityClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewUnityClient(t interface {
@@ -14,6 +14,11 @@ type Client struct {
 	mock.Mock
 }
 
+// ClearCookies provides a mock function with no fields
+func (_m *Client) ClearCookies() {
+	_m.Called()
+}
+
 // Delete provides a mock function with given fields: ctx, path, headers, resp
 func (_m *Client) Delete(ctx context.Context, path string, headers map[string]string, resp interface{}) error {
 	ret := _m.Called(ctx, path, headers, resp)
@@ -0,0 +1,209 @@
+/*
+ Copyright © 2025 Dell Inc. or its subsidiaries. All Rights Reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package gounity
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/dell/gounity/api"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ClientOptions holds the options of the client created by NewClientWithOptions: the options of its HTTP client
+// and those of the Unity client itself
+type ClientOptions struct {
+	api.ClientOptions
+
+	// ResourceCacheTTL is the time for which pools, tenants, IP interfaces, host initiators, NAS servers and
+	// I/O limit policies are cached. Caching is disabled if zero.
+	ResourceCacheTTL time.Duration
+}
+
+// Option configures the client created by NewClientWithOptions
+type Option func(opts *ClientOptions)
+
+// NewClientWithOptions initialize the new REST Client with the given options.
+//
+//	client, err := gounity.NewClientWithOptions(ctx, "https://10.0.0.1",
+//		gounity.WithRequestTimeout(2*time.Minute),
+//		gounity.WithCACertificatesFile("/etc/unity/ca.pem"),
+//		gounity.WithUserAgent("my-app/1.0"))
+func NewClientWithOptions(ctx context.Context, endpoint string, options ...Option) (UnityClient, error) {
+	opts := ClientOptions{}
+	for _, option := range options {
+		option(&opts)
+	}
+	client, err := NewClientWithClientOptions(ctx, endpoint, opts.ClientOptions)
+	if err != nil {
+		return nil, err
+	}
+	client.SetResourceCacheTTL(opts.ResourceCacheTTL)
+	return client, nil
+}
+
+// WithClientOptions replaces all the options set before it with the given HTTP client options
+func WithClientOptions(clientOptions api.ClientOptions) Option {
+	return func(opts *ClientOptions) {
+		*opts = ClientOptions{ClientOptions: clientOptions}
+	}
+}
+
+// WithInsecure disables the verification of the array certificate
+func WithInsecure(insecure bool) Option {
+	return func(opts *ClientOptions) {
+		opts.Insecure = insecure
+	}
+}
+
+// WithRequestTimeout limits the time of a whole request, including reading the response body
+func WithRequestTimeout(timeout time.Duration) Option {
+	return func(opts *ClientOptions) {
+		opts.Timeout = timeout
+	}
+}
+
+// WithDialTimeout limits the time to establish a TCP connection
+func WithDialTimeout(timeout time.Duration) Option {
+	return func(opts *ClientOptions) {
+		opts.DialTimeout = timeout
+	}
+}
+
+// WithTLSHandshakeTimeout limits the time of the TLS handshake
+func WithTLSHandshakeTimeout(timeout time.Duration) Option {
+	return func(opts *ClientOptions) {
+		opts.TLSHandshakeTimeout = timeout
+	}
+}
+
+// WithIdleConnTimeout sets how long an idle connection is kept open
+func WithIdleConnTimeout(timeout time.Duration) Option {
+	return func(opts *ClientOptions) {
+		opts.IdleConnTimeout = timeout
+	}
+}
+
+// WithMaxConnsPerHost limits the number of connections to the array
+func WithMaxConnsPerHost(maxConns int) Option {
+	return func(opts *ClientOptions) {
+		opts.MaxConnsPerHost = maxConns
+	}
+}
+
+// WithProxy sends the requests through the given HTTP proxy
+func WithProxy(proxyURL *url.URL) Option {
+	return func(opts *ClientOptions) {
+		opts.Proxy = http.ProxyURL(proxyURL)
+	}
+}
+
+// WithProxyFromEnvironment sends the requests through the proxy set by the HTTPS_PROXY and NO_PROXY environment variables
+func WithProxyFromEnvironment() Option {
+	return func(opts *ClientOptions) {
+		opts.Proxy = http.ProxyFromEnvironment
+	}
+}
+
+// WithTransport sends the requests through the given round tripper instead of the transport built from the
+// TLS, timeout, connection and proxy options
+func WithTransport(transport http.RoundTripper) Option {
+	return func(opts *ClientOptions) {
+		opts.Transport = transport
+	}
+}
+
+// WithUserAgent sets the User-Agent header of every request
+func WithUserAgent(userAgent string) Option {
+	return func(opts *ClientOptions) {
+		opts.UserAgent = userAgent
+	}
+}
+
+// WithCACertificates trusts the certificate authorities of the given PEM bundle in addition to the system pool
+func WithCACertificates(pemCerts []byte) Option {
+	return func(opts *ClientOptions) {
+		opts.CACertificates = pemCerts
+	}
+}
+
+// WithCACertificatesFile trusts the certificate authorities of the given PEM file in addition to the system pool
+func WithCACertificatesFile(path string) Option {
+	return func(opts *ClientOptions) {
+		opts.CACertificatesFile = path
+	}
+}
+
+// WithPinnedSPKIFingerprints requires the array to present a certificate matching one of the given fingerprints
+func WithPinnedSPKIFingerprints(fingerprints ...string) Option {
+	return func(opts *ClientOptions) {
+		opts.PinnedSPKIFingerprints = fingerprints
+	}
+}
+
+// WithServerName overrides the name used to verify the array certificate
+func WithServerName(serverName string) Option {
+	return func(opts *ClientOptions) {
+		opts.ServerName = serverName
+	}
+}
+
+// WithInterceptors adds interceptors observing or altering every request, such as api.LoggingInterceptor
+func WithInterceptors(interceptors ...api.Interceptor) Option {
+	return func(opts *ClientOptions) {
+		opts.Interceptors = append(opts.Interceptors, interceptors...)
+	}
+}
+
+// WithTracerProvider creates the spans of operations and requests with the given provider instead of the global one
+func WithTracerProvider(tp trace.TracerProvider) Option {
+	return func(opts *ClientOptions) {
+		opts.TracerProvider = tp
+	}
+}
+
+// WithMetricsRegisterer records the Prometheus metrics of the REST calls, such as their count and latency by route,
+// and registers them with the given registerer
+func WithMetricsRegisterer(registerer prometheus.Registerer) Option {
+	return func(opts *ClientOptions) {
+		opts.MetricsRegisterer = registerer
+	}
+}
+
+// WithReadLimit bounds the number of GET requests in progress and their rate
+func WithReadLimit(limit api.RequestLimit) Option {
+	return func(opts *ClientOptions) {
+		opts.ReadLimit = limit
+	}
+}
+
+// WithWriteLimit bounds the number of mutating requests in progress and their rate.
+// Mutating requests are limited separately from GET requests so that reads never delay them.
+func WithWriteLimit(limit api.RequestLimit) Option {
+	return func(opts *ClientOptions) {
+		opts.WriteLimit = limit
+	}
+}
+
+// WithResourceCacheTTL caches pools, tenants, IP interfaces, host initiators, NAS servers and I/O limit policies
+// for the given time. Use WithNoCache to bypass the cache for a call.
+func WithResourceCacheTTL(ttl time.Duration) Option {
+	return func(opts *ClientOptions) {
+		opts.ResourceCacheTTL = ttl
+	}
+}
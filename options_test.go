@@ -0,0 +1,156 @@
+/*
+ Copyright © 2025 Dell Inc. or its subsidiaries. All Rights Reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package gounity
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dell/gounity/api"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type roundTripperFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestNewClientWithOptions(t *testing.T) {
+	ctx := context.Background()
+	var headers http.Header
+	transport := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		headers = req.Header
+		return &http.Response{StatusCode: http.StatusOK, Header: http.Header{}, Body: io.NopCloser(strings.NewReader("{}")), Request: req}, nil
+	})
+
+	client, err := NewClientWithOptions(ctx, "https://unity.example.com", WithTransport(transport), WithUserAgent("gounity-test/1.0"))
+	require.NoError(t, err)
+	_, err = client.ListStoragePools(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, "gounity-test/1.0", headers.Get(api.HeaderKeyUserAgent))
+	assert.Equal(t, api.HeaderValContentTypeJSON, headers.Get(api.HeaderKeyAccept))
+	assert.Equal(t, "true", headers.Get(api.XEmcRestClient))
+
+	// Every option can be combined
+	_, err = NewClientWithOptions(ctx, "https://unity.example.com",
+		WithClientOptions(api.ClientOptions{ShowHTTP: false}),
+		WithInsecure(true),
+		WithDialTimeout(time.Second),
+		WithTLSHandshakeTimeout(time.Second),
+		WithIdleConnTimeout(time.Second),
+		WithMaxConnsPerHost(4),
+		WithProxyFromEnvironment(),
+		WithCACertificates(nil),
+		WithPinnedSPKIFingerprints(),
+		WithServerName("unity.example.com"),
+		WithInterceptors(api.LoggingInterceptor(logrus.DebugLevel)))
+	assert.NoError(t, err)
+
+	// Negative cases
+	_, err = NewClientWithOptions(ctx, "")
+	assert.Error(t, err)
+	_, err = NewClientWithOptions(ctx, "https://unity.example.com", WithCACertificatesFile("/nonexistent/ca.pem"))
+	assert.Error(t, err)
+}
+
+func TestNewClientWithOptionsTimeoutAndProxy(t *testing.T) {
+	ctx := context.Background()
+	var proxiedHost string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxiedHost = r.Host
+		if r.URL.Path == "/slow" {
+			time.Sleep(200 * time.Millisecond)
+		}
+		_, _ = w.Write([]byte("{}"))
+	}))
+	defer proxy.Close()
+	proxyURL, err := url.Parse(proxy.URL)
+	require.NoError(t, err)
+
+	client, err := NewClientWithOptions(ctx, "http://unity.example.com", WithProxy(proxyURL), WithRequestTimeout(50*time.Millisecond))
+	require.NoError(t, err)
+	impl := client.(*UnityClientImpl)
+	assert.NoError(t, impl.executeWithRetryAuthenticate(ctx, http.MethodGet, "/api/types/pool/instances", nil, nil))
+	assert.Equal(t, "unity.example.com", proxiedHost)
+
+	// Negative case
+	assert.Error(t, impl.executeWithRetryAuthenticate(ctx, http.MethodGet, "/slow", nil, nil))
+}
+
+func TestWithMetricsRegisterer(t *testing.T) {
+	ctx := context.Background()
+	lunRequests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// The session expired before the first request
+		if strings.HasPrefix(r.URL.Path, "/api/instances/lun/") {
+			lunRequests++
+			if lunRequests == 1 {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+		}
+		_, _ = w.Write([]byte("{}"))
+	}))
+	defer server.Close()
+
+	registry := prometheus.NewRegistry()
+	client, err := NewClientWithOptions(ctx, server.URL, WithMetricsRegisterer(registry))
+	require.NoError(t, err)
+	_, err = client.FindVolumeByID(ctx, "sv_1")
+	assert.NoError(t, err)
+
+	expected := `
+# HELP gounity_reauthentications_total Number of logins made after the session expired, by result.
+# TYPE gounity_reauthentications_total counter
+gounity_reauthentications_total{result="success"} 1
+# HELP gounity_retries_total Number of REST calls retried by method and route template.
+# TYPE gounity_retries_total counter
+gounity_retries_total{method="GET",route="/api/instances/lun/{id}"} 1
+`
+	assert.NoError(t, testutil.GatherAndCompare(registry, strings.NewReader(expected), "gounity_reauthentications_total", "gounity_retries_total"))
+	count, err := testutil.GatherAndCount(registry, "gounity_requests_total")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, count)
+
+	// Negative case: the metrics cannot be registered
+	conflicting := prometheus.NewRegistry()
+	require.NoError(t, conflicting.Register(prometheus.NewCounter(prometheus.CounterOpts{Name: "gounity_requests_in_flight", Help: "Conflicting metric."})))
+	_, err = NewClientWithOptions(ctx, server.URL, WithMetricsRegisterer(conflicting))
+	assert.Error(t, err)
+}
+
+func TestWithRequestLimits(t *testing.T) {
+	opts := ClientOptions{}
+	WithReadLimit(api.RequestLimit{MaxInFlight: 4, Rate: 10})(&opts)
+	WithWriteLimit(api.RequestLimit{MaxInFlight: 1})(&opts)
+	assert.Equal(t, api.RequestLimit{MaxInFlight: 4, Rate: 10}, opts.ReadLimit)
+	assert.Equal(t, api.RequestLimit{MaxInFlight: 1}, opts.WriteLimit)
+
+	// WithClientOptions replaces the Unity client options too
+	WithResourceCacheTTL(time.Minute)(&opts)
+	WithClientOptions(api.ClientOptions{Insecure: true})(&opts)
+	assert.Equal(t, ClientOptions{ClientOptions: api.ClientOptions{Insecure: true}}, opts)
+}
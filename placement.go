@@ -0,0 +1,207 @@
+/*
+ Copyright © 2025 Dell Inc. or its subsidiaries. All Rights Reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package gounity
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+
+	types "github.com/dell/gounity/apitypes"
+	util "github.com/dell/gounity/gounityutil"
+)
+
+// Default placement policy values
+const (
+	DefaultMaxSubscriptionRatio = 3.0
+	DefaultMinFreeRatio         = 0.05
+)
+
+// ErrorNoEligiblePool is returned by PlaceVolume when no pool satisfies the placement request
+var ErrorNoEligiblePool = errors.New("no storage pool satisfies the placement request")
+
+// PlacementRequest holds the requirements of a new volume
+type PlacementRequest struct {
+	Size                   uint64
+	IsThinEnabled          bool
+	IsDataReductionEnabled bool
+	RequireAllFlash        bool
+	RequireFASTVP          bool
+	RequireFASTCache       bool
+	// PoolIDs restricts the candidates to the given pool IDs. All pools are candidates if empty.
+	PoolIDs []string
+}
+
+// PlacementPolicy holds the capacity limits applied to the candidate pools
+type PlacementPolicy struct {
+	// MaxSubscriptionRatio is the maximum ratio of subscribed to total pool capacity after placement
+	MaxSubscriptionRatio float64
+	// ArraySubscriptionRatios overrides MaxSubscriptionRatio for the arrays with the given keys
+	ArraySubscriptionRatios map[string]float64
+	// MinFreeRatio is the fraction of the pool capacity which must stay free after placement
+	MinFreeRatio float64
+}
+
+// DefaultPlacementPolicy returns the default placement policy
+func DefaultPlacementPolicy() PlacementPolicy {
+	return PlacementPolicy{
+		MaxSubscriptionRatio: DefaultMaxSubscriptionRatio,
+		MinFreeRatio:         DefaultMinFreeRatio,
+	}
+}
+
+// maxSubscriptionRatio returns the subscription limit of the given array
+func (p PlacementPolicy) maxSubscriptionRatio(array string) float64 {
+	if ratio, ok := p.ArraySubscriptionRatios[array]; ok {
+		return ratio
+	}
+	return p.MaxSubscriptionRatio
+}
+
+// PlacementCandidate holds the evaluation of a pool for a placement request.
+// Reasons explains why the pool is not eligible, or how it was scored if it is.
+type PlacementCandidate struct {
+	Array             string
+	Client            UnityClient
+	Pool              types.StoragePoolContent
+	Eligible          bool
+	FreeRatio         float64
+	SubscriptionRatio float64
+	Reasons           []string
+}
+
+// PlacementResult holds the ranked candidates and the arrays whose pools could not be listed
+type PlacementResult struct {
+	// Candidates holds the eligible pools ranked best first, followed by the ineligible pools
+	Candidates []PlacementCandidate
+	Errors     map[string]error
+}
+
+// Best returns the best eligible candidate or nil if no pool is eligible
+func (r *PlacementResult) Best() *PlacementCandidate {
+	if len(r.Candidates) == 0 || !r.Candidates[0].Eligible {
+		return nil
+	}
+	return &r.Candidates[0]
+}
+
+// PlaceVolume evaluates the pools of every given array against the placement request and policy.
+// The clients are keyed by a name identifying the array. Eligible pools are ranked by the fraction of
+// pool capacity left free after placement, then by the lower subscription ratio.
+// ErrorNoEligiblePool is returned along with the result if no pool is eligible.
+func PlaceVolume(ctx context.Context, clients map[string]UnityClient, req PlacementRequest, policy PlacementPolicy) (*PlacementResult, error) {
+	log := util.GetRunIDLogger(ctx)
+	if req.Size == 0 {
+		return nil, errors.New("volume size should be greater than 0")
+	}
+
+	result := &PlacementResult{Errors: map[string]error{}}
+	for array, client := range clients {
+		// free capacity changes with every write, so it is read from the array rather than the pool cache
+		pools, err := client.ListStoragePools(WithNoCache(ctx))
+		if err != nil {
+			log.Warnf("PlaceVolume: unable to list pools of array %s: %v", array, err)
+			result.Errors[array] = err
+			continue
+		}
+		for _, pool := range pools {
+			if len(req.PoolIDs) > 0 && !slices.Contains(req.PoolIDs, pool.StoragePoolContent.ID) {
+				continue
+			}
+			result.Candidates = append(result.Candidates, evaluatePool(array, client, pool.StoragePoolContent, req, policy))
+		}
+	}
+
+	sort.SliceStable(result.Candidates, func(i, j int) bool {
+		a, b := result.Candidates[i], result.Candidates[j]
+		if a.Eligible != b.Eligible {
+			return a.Eligible
+		}
+		if a.FreeRatio != b.FreeRatio {
+			return a.FreeRatio > b.FreeRatio
+		}
+		if a.SubscriptionRatio != b.SubscriptionRatio {
+			return a.SubscriptionRatio < b.SubscriptionRatio
+		}
+		return a.Array+a.Pool.ID < b.Array+b.Pool.ID
+	})
+
+	best := result.Best()
+	if best == nil {
+		return result, ErrorNoEligiblePool
+	}
+	log.Debugf("PlaceVolume: selected pool %s of array %s", best.Pool.ID, best.Array)
+	return result, nil
+}
+
+// evaluatePool checks the pool against the placement request and computes its capacity ratios after placement
+func evaluatePool(array string, client UnityClient, pool types.StoragePoolContent, req PlacementRequest, policy PlacementPolicy) PlacementCandidate {
+	candidate := PlacementCandidate{Array: array, Client: client, Pool: pool, Eligible: true}
+	reject := func(format string, args ...interface{}) {
+		candidate.Eligible = false
+		candidate.Reasons = append(candidate.Reasons, fmt.Sprintf(format, args...))
+	}
+
+	if pool.TotalCapacity == 0 {
+		reject("pool reports no capacity")
+		return candidate
+	}
+
+	if req.RequireAllFlash && !pool.IsAllFlash {
+		reject("pool is not all-flash")
+	}
+	if req.RequireFASTVP && !pool.PoolFastVP.IsScheduleEnabled {
+		reject("FAST VP relocation is not scheduled on the pool")
+	}
+	if req.RequireFASTCache && !pool.IsFASTCacheEnabled {
+		reject("FAST Cache is not enabled on the pool")
+	}
+	if req.IsDataReductionEnabled {
+		if !client.Supports(FeatureDataReduction) {
+			reject("array does not support data reduction")
+		} else if !pool.IsAllFlash {
+			reject("data reduction requires an all-flash pool")
+		}
+	}
+
+	// Thick volumes allocate their whole size up front, thin volumes only subscribe it
+	free := pool.FreeCapacity
+	if !req.IsThinEnabled {
+		if free < req.Size {
+			free = 0
+		} else {
+			free -= req.Size
+		}
+	}
+	total := float64(pool.TotalCapacity)
+	candidate.FreeRatio = float64(free) / total
+	candidate.SubscriptionRatio = float64(pool.SubscribedCapacity+req.Size) / total
+
+	if !req.IsThinEnabled && pool.FreeCapacity < req.Size {
+		reject("pool free capacity %d is less than the volume size %d", pool.FreeCapacity, req.Size)
+	} else if candidate.FreeRatio < policy.MinFreeRatio {
+		reject("free capacity after placement %.1f%% is below the minimum of %.1f%%", candidate.FreeRatio*100, policy.MinFreeRatio*100)
+	}
+	if maxRatio := policy.maxSubscriptionRatio(array); maxRatio > 0 && candidate.SubscriptionRatio > maxRatio {
+		reject("subscription ratio after placement %.2f exceeds the maximum of %.2f", candidate.SubscriptionRatio, maxRatio)
+	}
+
+	if candidate.Eligible {
+		candidate.Reasons = append(candidate.Reasons, fmt.Sprintf("%.1f%% free and subscription ratio %.2f after placement", candidate.FreeRatio*100, candidate.SubscriptionRatio))
+	}
+	return candidate
+}
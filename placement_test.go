@@ -0,0 +1,96 @@
+/*
+ Copyright © 2025 Dell Inc. or its subsidiaries. All Rights Reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package gounity
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	types "github.com/dell/gounity/apitypes"
+	mocksapi "github.com/dell/gounity/mocks/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const gib = uint64(1024 * 1024 * 1024)
+
+func newPlacementTestClient(pools ...types.StoragePoolContent) *UnityClientImpl {
+	apiClient := &mocksapi.Client{}
+	apiClient.On("DoWithHeaders", anyArgs...).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(*types.ListStoragePools)
+		for _, pool := range pools {
+			resp.StoragePools = append(resp.StoragePools, types.StoragePool{StoragePoolContent: pool})
+		}
+	})
+	return &UnityClientImpl{api: apiClient, configConnect: &ConfigConnect{}}
+}
+
+func TestPlaceVolume(t *testing.T) {
+	ctx := context.Background()
+	arrayA := newPlacementTestClient(
+		types.StoragePoolContent{ID: "pool_1", TotalCapacity: 100 * gib, FreeCapacity: 20 * gib, SubscribedCapacity: 250 * gib, IsAllFlash: true},
+		types.StoragePoolContent{ID: "pool_2", TotalCapacity: 100 * gib, FreeCapacity: 60 * gib, SubscribedCapacity: 100 * gib},
+	)
+	arrayB := newPlacementTestClient(
+		types.StoragePoolContent{ID: "pool_1", TotalCapacity: 200 * gib, FreeCapacity: 100 * gib, SubscribedCapacity: 150 * gib, IsAllFlash: true},
+	)
+	clients := map[string]UnityClient{"array-a": arrayA, "array-b": arrayB}
+
+	// Thin volume, ranked by free capacity
+	result, err := PlaceVolume(ctx, clients, PlacementRequest{Size: 10 * gib, IsThinEnabled: true}, DefaultPlacementPolicy())
+	assert.NoError(t, err)
+	assert.Len(t, result.Candidates, 3)
+	assert.Equal(t, "array-a", result.Best().Array)
+	assert.Equal(t, "pool_2", result.Best().Pool.ID)
+
+	// Subscription limit excludes the oversubscribed pool
+	policy := DefaultPlacementPolicy()
+	policy.ArraySubscriptionRatios = map[string]float64{"array-a": 2.0}
+	result, err = PlaceVolume(ctx, clients, PlacementRequest{Size: 10 * gib, IsThinEnabled: true, RequireAllFlash: true}, policy)
+	assert.NoError(t, err)
+	assert.Equal(t, "array-b", result.Best().Array)
+	last := result.Candidates[len(result.Candidates)-1]
+	assert.False(t, last.Eligible)
+	assert.Contains(t, last.Reasons[len(last.Reasons)-1], "subscription ratio")
+
+	// Data reduction requires an all-flash pool on an array supporting it
+	arrayB.setCapabilities(ctx, &types.Content{SoftwareVersion: "4.2.0"})
+	result, err = PlaceVolume(ctx, clients, PlacementRequest{Size: 10 * gib, IsThinEnabled: true, IsDataReductionEnabled: true}, DefaultPlacementPolicy())
+	assert.NoError(t, err)
+	assert.Equal(t, "pool_1", result.Best().Pool.ID)
+	assert.Equal(t, "array-a", result.Best().Array)
+
+	// Thick volume larger than the free capacity of every pool
+	result, err = PlaceVolume(ctx, clients, PlacementRequest{Size: 150 * gib}, DefaultPlacementPolicy())
+	assert.ErrorIs(t, err, ErrorNoEligiblePool)
+	assert.Nil(t, result.Best())
+	for _, candidate := range result.Candidates {
+		assert.NotEmpty(t, candidate.Reasons)
+	}
+
+	// Arrays which cannot be listed are reported
+	failing := &mocksapi.Client{}
+	failing.On("DoWithHeaders", anyArgs...).Return(errors.New("array unreachable"))
+	clients["array-c"] = &UnityClientImpl{api: failing, configConnect: &ConfigConnect{}}
+	result, err = PlaceVolume(ctx, clients, PlacementRequest{Size: 10 * gib, IsThinEnabled: true, PoolIDs: []string{"pool_1"}}, DefaultPlacementPolicy())
+	assert.NoError(t, err)
+	assert.Len(t, result.Candidates, 2)
+	assert.Error(t, result.Errors["array-c"])
+
+	// Negative case
+	_, err = PlaceVolume(ctx, clients, PlacementRequest{}, DefaultPlacementPolicy())
+	assert.Error(t, err)
+}
@@ -0,0 +1,267 @@
+/*
+ Copyright © 2025 Dell Inc. or its subsidiaries. All Rights Reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package reconcile
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	util "github.com/dell/gounity/gounityutil"
+	"gopkg.in/yaml.v3"
+)
+
+// Manifest describes the desired state of an array. Resources which are not listed are left untouched.
+// A resource marked absent is deleted if it exists.
+type Manifest struct {
+	Pools             []Pool             `json:"pools,omitempty" yaml:"pools,omitempty"`
+	SnapshotSchedules []SnapshotSchedule `json:"snapshotSchedules,omitempty" yaml:"snapshotSchedules,omitempty"`
+	Hosts             []Host             `json:"hosts,omitempty" yaml:"hosts,omitempty"`
+	LUNs              []LUN              `json:"luns,omitempty" yaml:"luns,omitempty"`
+	Filesystems       []Filesystem       `json:"filesystems,omitempty" yaml:"filesystems,omitempty"`
+	NFSShares         []NFSShare         `json:"nfsShares,omitempty" yaml:"nfsShares,omitempty"`
+}
+
+// Pool is a storage pool. RaidGroups are only used to create the pool.
+type Pool struct {
+	Name        string      `json:"name" yaml:"name"`
+	Description string      `json:"description,omitempty" yaml:"description,omitempty"`
+	RaidGroups  []RaidGroup `json:"raidGroups,omitempty" yaml:"raidGroups,omitempty"`
+	Absent      bool        `json:"absent,omitempty" yaml:"absent,omitempty"`
+}
+
+// RaidGroup is a set of disks of a disk group added to a pool, see gounity.NewRaidGroupParameters
+type RaidGroup struct {
+	DiskGroup   string `json:"diskGroup" yaml:"diskGroup"`
+	NumDisks    int    `json:"numDisks" yaml:"numDisks"`
+	RaidType    int    `json:"raidType" yaml:"raidType"`
+	StripeWidth int    `json:"stripeWidth,omitempty" yaml:"stripeWidth,omitempty"`
+}
+
+// SnapshotSchedule is a snapshot schedule. The rules of an existing schedule cannot be changed.
+type SnapshotSchedule struct {
+	Name   string         `json:"name" yaml:"name"`
+	Rules  []ScheduleRule `json:"rules,omitempty" yaml:"rules,omitempty"`
+	Absent bool           `json:"absent,omitempty" yaml:"absent,omitempty"`
+}
+
+// ScheduleRule is a rule of a snapshot schedule. Type is a gounity.SnapScheduleRuleType.
+type ScheduleRule struct {
+	Type          int    `json:"type" yaml:"type"`
+	Minute        int    `json:"minute,omitempty" yaml:"minute,omitempty"`
+	Hours         []int  `json:"hours,omitempty" yaml:"hours,omitempty"`
+	DaysOfWeek    []int  `json:"daysOfWeek,omitempty" yaml:"daysOfWeek,omitempty"`
+	DaysOfMonth   []int  `json:"daysOfMonth,omitempty" yaml:"daysOfMonth,omitempty"`
+	Interval      int    `json:"interval,omitempty" yaml:"interval,omitempty"`
+	IsAutoDelete  bool   `json:"isAutoDelete,omitempty" yaml:"isAutoDelete,omitempty"`
+	RetentionTime uint64 `json:"retentionTime,omitempty" yaml:"retentionTime,omitempty"`
+}
+
+// Host is a host and its initiators. Initiators attached to the host but not listed are left untouched.
+type Host struct {
+	Name string `json:"name" yaml:"name"`
+	// Tenant is the ID of the tenant of the host, only used to create it
+	Tenant     string      `json:"tenant,omitempty" yaml:"tenant,omitempty"`
+	Initiators []Initiator `json:"initiators,omitempty" yaml:"initiators,omitempty"`
+	Absent     bool        `json:"absent,omitempty" yaml:"absent,omitempty"`
+}
+
+// Initiator is a host initiator
+type Initiator struct {
+	// ID is the WWN or IQN of the initiator
+	ID string `json:"id" yaml:"id"`
+	// Type is fc or iscsi
+	Type string `json:"type" yaml:"type"`
+}
+
+// Initiator types
+const (
+	InitiatorTypeFC    = "fc"
+	InitiatorTypeISCSI = "iscsi"
+)
+
+// LUN is a block volume. Hosts lists the names of the hosts having access to it; a nil list leaves the host access
+// untouched and an empty list removes every host. An empty Description, TieringPolicy, IOLimitPolicy or
+// SnapshotSchedule leaves the value of an existing LUN untouched.
+type LUN struct {
+	Name          string `json:"name" yaml:"name"`
+	Description   string `json:"description,omitempty" yaml:"description,omitempty"`
+	Pool          string `json:"pool" yaml:"pool"`
+	Size          Size   `json:"size" yaml:"size"`
+	Thin          *bool  `json:"thin,omitempty" yaml:"thin,omitempty"`
+	DataReduction bool   `json:"dataReduction,omitempty" yaml:"dataReduction,omitempty"`
+	TieringPolicy *int   `json:"tieringPolicy,omitempty" yaml:"tieringPolicy,omitempty"`
+	// IOLimitPolicy is the name of the host I/O limit policy
+	IOLimitPolicy string `json:"ioLimitPolicy,omitempty" yaml:"ioLimitPolicy,omitempty"`
+	// SnapshotSchedule is the name of the snapshot schedule
+	SnapshotSchedule string   `json:"snapshotSchedule,omitempty" yaml:"snapshotSchedule,omitempty"`
+	Hosts            []string `json:"hosts,omitempty" yaml:"hosts,omitempty"`
+	Absent           bool     `json:"absent,omitempty" yaml:"absent,omitempty"`
+}
+
+// Filesystem is a filesystem served by a NAS server. An empty Description, TieringPolicy or SnapshotSchedule leaves
+// the value of an existing filesystem untouched.
+type Filesystem struct {
+	Name        string `json:"name" yaml:"name"`
+	Description string `json:"description,omitempty" yaml:"description,omitempty"`
+	Pool        string `json:"pool" yaml:"pool"`
+	// NASServer is the ID of the NAS server
+	NASServer     string `json:"nasServer" yaml:"nasServer"`
+	Size          Size   `json:"size" yaml:"size"`
+	Thin          *bool  `json:"thin,omitempty" yaml:"thin,omitempty"`
+	DataReduction bool   `json:"dataReduction,omitempty" yaml:"dataReduction,omitempty"`
+	TieringPolicy *int   `json:"tieringPolicy,omitempty" yaml:"tieringPolicy,omitempty"`
+	// HostIOSize defaults to 8192
+	HostIOSize int `json:"hostIOSize,omitempty" yaml:"hostIOSize,omitempty"`
+	// SnapshotSchedule is the name of the snapshot schedule
+	SnapshotSchedule string `json:"snapshotSchedule,omitempty" yaml:"snapshotSchedule,omitempty"`
+	Absent           bool   `json:"absent,omitempty" yaml:"absent,omitempty"`
+}
+
+// NFSShare is an NFS share of a filesystem. Each host list holds host names; a nil list leaves that access untouched
+// and an empty list removes every host.
+type NFSShare struct {
+	Name       string `json:"name" yaml:"name"`
+	Filesystem string `json:"filesystem" yaml:"filesystem"`
+	// Path defaults to /
+	Path string `json:"path,omitempty" yaml:"path,omitempty"`
+	// DefaultAccess is none, readOnly, readWrite, readOnlyRoot or readWriteRoot, only used to create the share
+	DefaultAccess     string   `json:"defaultAccess,omitempty" yaml:"defaultAccess,omitempty"`
+	ReadOnlyHosts     []string `json:"readOnlyHosts,omitempty" yaml:"readOnlyHosts,omitempty"`
+	ReadWriteHosts    []string `json:"readWriteHosts,omitempty" yaml:"readWriteHosts,omitempty"`
+	ReadOnlyRootHosts []string `json:"readOnlyRootHosts,omitempty" yaml:"readOnlyRootHosts,omitempty"`
+	RootHosts         []string `json:"rootHosts,omitempty" yaml:"rootHosts,omitempty"`
+	Absent            bool     `json:"absent,omitempty" yaml:"absent,omitempty"`
+}
+
+// Size is a size in bytes. It can be written as a number of bytes or with a binary suffix, such as 10Gi.
+type Size uint64
+
+// ParseSize parses a number of bytes with an optional Ki, Mi, Gi, Ti or Pi suffix
+func ParseSize(value string) (Size, error) {
+	size, err := util.ParseSize(value)
+	return Size(size), err
+}
+
+// UnmarshalYAML parses a size written as a number or a string
+func (s *Size) UnmarshalYAML(node *yaml.Node) error {
+	size, err := ParseSize(node.Value)
+	if err != nil {
+		return err
+	}
+	*s = size
+	return nil
+}
+
+// String returns the size with the largest binary suffix dividing it
+func (s Size) String() string {
+	return util.FormatSize(uint64(s))
+}
+
+// ParseManifest parses a YAML or JSON manifest and validates it
+func ParseManifest(data []byte) (*Manifest, error) {
+	manifest := &Manifest{}
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(manifest); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("unable to parse manifest: %v", err)
+	}
+	if err := manifest.Validate(); err != nil {
+		return nil, err
+	}
+	return manifest, nil
+}
+
+// LoadManifest reads and parses a YAML or JSON manifest file
+func LoadManifest(path string) (*Manifest, error) {
+	data, err := os.ReadFile(path) // #nosec G304
+	if err != nil {
+		return nil, fmt.Errorf("unable to read manifest %s: %v", path, err)
+	}
+	return ParseManifest(data)
+}
+
+// Validate checks that every resource is named once and references valid values
+func (m *Manifest) Validate() error {
+	var problems []string
+	names := map[string]bool{}
+	checkName := func(kind Kind, name string) {
+		if name == "" {
+			problems = append(problems, fmt.Sprintf("a %s has no name", kind))
+			return
+		}
+		key := string(kind) + "/" + name
+		if names[key] {
+			problems = append(problems, fmt.Sprintf("%s %s is listed more than once", kind, name))
+		}
+		names[key] = true
+	}
+
+	for _, pool := range m.Pools {
+		checkName(KindPool, pool.Name)
+	}
+	absentSchedules := map[string]bool{}
+	for _, schedule := range m.SnapshotSchedules {
+		checkName(KindSnapshotSchedule, schedule.Name)
+		if !schedule.Absent && len(schedule.Rules) == 0 {
+			problems = append(problems, fmt.Sprintf("snapshot schedule %s has no rules", schedule.Name))
+		}
+		absentSchedules[schedule.Name] = schedule.Absent
+	}
+	checkSchedule := func(kind Kind, name, schedule string) {
+		if absentSchedules[schedule] {
+			problems = append(problems, fmt.Sprintf("%s %s uses snapshot schedule %s which is absent", kind, name, schedule))
+		}
+	}
+	for _, host := range m.Hosts {
+		checkName(KindHost, host.Name)
+		for _, initiator := range host.Initiators {
+			if initiator.Type != InitiatorTypeFC && initiator.Type != InitiatorTypeISCSI {
+				problems = append(problems, fmt.Sprintf("initiator %s of host %s has invalid type %q", initiator.ID, host.Name, initiator.Type))
+			}
+		}
+	}
+	for _, lun := range m.LUNs {
+		checkName(KindLUN, lun.Name)
+		if !lun.Absent && (lun.Pool == "" || lun.Size == 0) {
+			problems = append(problems, fmt.Sprintf("lun %s needs a pool and a size", lun.Name))
+		}
+		checkSchedule(KindLUN, lun.Name, lun.SnapshotSchedule)
+	}
+	for _, filesystem := range m.Filesystems {
+		checkName(KindFilesystem, filesystem.Name)
+		if !filesystem.Absent && (filesystem.Pool == "" || filesystem.NASServer == "" || filesystem.Size == 0) {
+			problems = append(problems, fmt.Sprintf("filesystem %s needs a pool, a NAS server and a size", filesystem.Name))
+		}
+		checkSchedule(KindFilesystem, filesystem.Name, filesystem.SnapshotSchedule)
+	}
+	for _, share := range m.NFSShares {
+		checkName(KindNFSShare, share.Name)
+		if !share.Absent && share.Filesystem == "" {
+			problems = append(problems, fmt.Sprintf("nfsShare %s needs a filesystem", share.Name))
+		}
+		if _, ok := defaultAccesses[share.DefaultAccess]; !ok {
+			problems = append(problems, fmt.Sprintf("nfsShare %s has invalid default access %q", share.Name, share.DefaultAccess))
+		}
+	}
+
+	if len(problems) > 0 {
+		return fmt.Errorf("invalid manifest: %s", strings.Join(problems, "; "))
+	}
+	return nil
+}
@@ -0,0 +1,120 @@
+/*
+ Copyright © 2025 Dell Inc. or its subsidiaries. All Rights Reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package reconcile
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseSize(t *testing.T) {
+	tests := map[string]Size{
+		"1024":  1024,
+		"10Gi":  10 << 30,
+		"512Mi": 512 << 20,
+		"1Ti":   1 << 40,
+	}
+	for value, expected := range tests {
+		size, err := ParseSize(value)
+		assert.NoError(t, err, value)
+		assert.Equal(t, expected, size, value)
+	}
+	assert.Equal(t, "10Gi", Size(10<<30).String())
+	assert.Equal(t, "1000", Size(1000).String())
+
+	// Negative cases
+	for _, value := range []string{"", "10GB", "-1", "Gi"} {
+		_, err := ParseSize(value)
+		assert.Error(t, err, value)
+	}
+}
+
+func TestParseManifest(t *testing.T) {
+	data := `
+pools:
+  - name: pool1
+hosts:
+  - name: host1
+    initiators:
+      - id: iqn.1993-08.org.debian:01:abc
+        type: iscsi
+luns:
+  - name: vol1
+    pool: pool1
+    size: 10Gi
+    thin: false
+    tieringPolicy: 0
+    snapshotSchedule: daily
+    hosts: [host1]
+filesystems:
+  - name: fs1
+    pool: pool1
+    nasServer: nas_1
+    size: 5Gi
+    snapshotSchedule: daily
+nfsShares:
+  - name: share1
+    filesystem: fs1
+    defaultAccess: readOnly
+    rootHosts: []
+`
+	manifest, err := ParseManifest([]byte(data))
+	assert.NoError(t, err)
+	assert.Equal(t, Size(10<<30), manifest.LUNs[0].Size)
+	assert.False(t, *manifest.LUNs[0].Thin)
+	assert.Equal(t, []string{"host1"}, manifest.LUNs[0].Hosts)
+	assert.Equal(t, 0, *manifest.LUNs[0].TieringPolicy)
+	assert.Equal(t, "daily", manifest.LUNs[0].SnapshotSchedule)
+	assert.Nil(t, manifest.Filesystems[0].TieringPolicy)
+	assert.Equal(t, "daily", manifest.Filesystems[0].SnapshotSchedule)
+	assert.NotNil(t, manifest.NFSShares[0].RootHosts)
+	assert.Nil(t, manifest.NFSShares[0].ReadOnlyHosts)
+	assert.Equal(t, InitiatorTypeISCSI, manifest.Hosts[0].Initiators[0].Type)
+
+	// JSON is valid YAML
+	manifest, err = ParseManifest([]byte(`{"luns": [{"name": "vol1", "pool": "pool1", "size": 1073741824}]}`))
+	assert.NoError(t, err)
+	assert.Equal(t, Size(1<<30), manifest.LUNs[0].Size)
+
+	manifest, err = ParseManifest(nil)
+	assert.NoError(t, err)
+	assert.Empty(t, manifest.LUNs)
+
+	path := filepath.Join(t.TempDir(), "manifest.yaml")
+	assert.NoError(t, os.WriteFile(path, []byte(data), 0o600))
+	manifest, err = LoadManifest(path)
+	assert.NoError(t, err)
+	assert.Len(t, manifest.Filesystems, 1)
+
+	// Negative cases
+	_, err = ParseManifest([]byte("luns:\n  - name: vol1\n    pool: pool1\n    size: 1Gi\n    color: red\n"))
+	assert.ErrorContains(t, err, "unable to parse manifest")
+
+	_, err = ParseManifest([]byte("luns:\n  - name: vol1\n    size: 1Gi\n  - name: vol1\n    pool: pool1\n    size: 1Gi\n"))
+	assert.ErrorContains(t, err, "lun vol1 needs a pool and a size")
+	assert.ErrorContains(t, err, "lun vol1 is listed more than once")
+
+	_, err = ParseManifest([]byte("nfsShares:\n  - name: share1\n    filesystem: fs1\n    defaultAccess: all\n"))
+	assert.ErrorContains(t, err, "invalid default access")
+
+	_, err = ParseManifest([]byte("hosts:\n  - name: host1\n    initiators:\n      - id: 10:00\n        type: nvme\n"))
+	assert.ErrorContains(t, err, "invalid type")
+
+	_, err = LoadManifest(filepath.Join(t.TempDir(), "missing.yaml"))
+	assert.ErrorContains(t, err, "unable to read manifest")
+}
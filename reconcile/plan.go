@@ -0,0 +1,905 @@
+/*
+ Copyright © 2025 Dell Inc. or its subsidiaries. All Rights Reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package reconcile
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/dell/gounity"
+	"github.com/dell/gounity/api"
+	types "github.com/dell/gounity/apitypes"
+)
+
+// Kind is a kind of resource managed by a manifest
+type Kind string
+
+// Kind constants, in the order resources are created
+const (
+	KindPool             Kind = "pool"
+	KindSnapshotSchedule Kind = "snapshotSchedule"
+	KindHost             Kind = "host"
+	KindInitiator        Kind = "initiator"
+	KindLUN              Kind = "lun"
+	KindFilesystem       Kind = "filesystem"
+	KindNFSShare         Kind = "nfsShare"
+	KindHostAccess       Kind = "hostAccess"
+)
+
+// Action is what a change does to a resource
+type Action string
+
+// Action constants
+const (
+	ActionCreate Action = "create"
+	ActionModify Action = "modify"
+	ActionDelete Action = "delete"
+)
+
+// defaultHostIOSize is the host I/O size of filesystems which do not set one
+const defaultHostIOSize = 8192
+
+// defaultAccesses maps the default access of NFS shares in manifests to the Unity values
+var defaultAccesses = map[string]gounity.NFSShareDefaultAccess{
+	"":              gounity.NoneDefaultAccess,
+	"none":          gounity.NoneDefaultAccess,
+	"readOnly":      gounity.ReadOnlyDefaultAccess,
+	"readWrite":     gounity.ReadWriteDefaultAccess,
+	"readOnlyRoot":  gounity.ReadOnlyRootDefaultAccess,
+	"readWriteRoot": gounity.ReadWriteRootDefaultAccess,
+}
+
+// Change is a planned change of a resource
+type Change struct {
+	Kind Kind `json:"kind"`
+	// Name is the name of the resource. For host access it is the kind and name of the LUN or NFS share, such as lun/vol1.
+	Name   string `json:"name"`
+	Action Action `json:"action"`
+	// Details describes what changes, such as "size 10Gi -> 20Gi"
+	Details []string `json:"details,omitempty"`
+	// Destructive is true for deletions and removals of host access
+	Destructive bool `json:"destructive,omitempty"`
+
+	apply func(ctx context.Context) error
+}
+
+func (c Change) String() string {
+	s := fmt.Sprintf("%s %s %s", c.Action, c.Kind, c.Name)
+	if len(c.Details) > 0 {
+		s += " (" + strings.Join(c.Details, ", ") + ")"
+	}
+	return s
+}
+
+// Plan holds the changes reaching the state of a manifest, in the order they are applied: creations and
+// modifications from pools to NFS shares, then host access, then deletions from NFS shares to pools.
+type Plan struct {
+	Changes []Change `json:"changes"`
+	// Conflicts are differences which cannot be reconciled, such as shrinking a LUN. A plan with conflicts is not applied.
+	Conflicts []string `json:"conflicts,omitempty"`
+}
+
+// Destructive returns the destructive changes of the plan
+func (p *Plan) Destructive() []Change {
+	var destructive []Change
+	for _, change := range p.Changes {
+		if change.Destructive {
+			destructive = append(destructive, change)
+		}
+	}
+	return destructive
+}
+
+// planner computes a plan from the manifest and the state of the array
+type planner struct {
+	client gounity.UnityClient
+	// upserts are the creations and modifications, access the host access changes and deletes the deletions, in planning order
+	upserts   []Change
+	access    []Change
+	deletes   []Change
+	conflicts []string
+
+	poolIDs          map[string]string
+	scheduleIDs      map[string]string
+	plannedSchedules map[string]bool
+	hostIDs          map[string]string
+	plannedHosts     map[string]bool
+	filesystemIDs    map[string]string
+}
+
+func newPlanner(client gounity.UnityClient) *planner {
+	return &planner{
+		client:           client,
+		poolIDs:          map[string]string{},
+		scheduleIDs:      map[string]string{},
+		plannedSchedules: map[string]bool{},
+		hostIDs:          map[string]string{},
+		plannedHosts:     map[string]bool{},
+		filesystemIDs:    map[string]string{},
+	}
+}
+
+func (p *planner) conflict(format string, args ...interface{}) {
+	p.conflicts = append(p.conflicts, fmt.Sprintf(format, args...))
+}
+
+func (p *planner) plan(ctx context.Context, m *Manifest) (*Plan, error) {
+	steps := []func(context.Context, *Manifest) error{
+		p.planPools,
+		p.planSnapshotSchedules,
+		p.planHosts,
+		p.planLUNs,
+		p.planFilesystems,
+		p.planNFSShares,
+	}
+	for _, step := range steps {
+		if err := step(ctx, m); err != nil {
+			return nil, err
+		}
+	}
+
+	changes := append(append([]Change{}, p.upserts...), p.access...)
+	for i := len(p.deletes) - 1; i >= 0; i-- {
+		changes = append(changes, p.deletes[i])
+	}
+	return &Plan{Changes: changes, Conflicts: p.conflicts}, nil
+}
+
+func (p *planner) planPools(ctx context.Context, m *Manifest) error {
+	if len(m.Pools) == 0 && len(m.LUNs) == 0 && len(m.Filesystems) == 0 {
+		return nil
+	}
+	pools, err := p.client.ListStoragePools(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to read pools: %v", err)
+	}
+	existing := map[string]types.StoragePoolContent{}
+	for _, pool := range pools {
+		existing[pool.StoragePoolContent.Name] = pool.StoragePoolContent
+		p.poolIDs[pool.StoragePoolContent.Name] = pool.StoragePoolContent.ID
+	}
+
+	for _, pool := range m.Pools {
+		current, exists := existing[pool.Name]
+		switch {
+		case pool.Absent:
+			if exists {
+				p.deletes = append(p.deletes, Change{
+					Kind: KindPool, Name: pool.Name, Action: ActionDelete, Destructive: true,
+					apply: func(ctx context.Context) error {
+						return p.client.DeleteStoragePool(ctx, current.ID)
+					},
+				})
+			}
+		case !exists:
+			if len(pool.RaidGroups) == 0 {
+				p.conflict("pool %s does not exist and has no raid groups to create it from", pool.Name)
+				continue
+			}
+			raidGroups := make([]types.RaidGroupParameters, 0, len(pool.RaidGroups))
+			for _, group := range pool.RaidGroups {
+				raidGroups = append(raidGroups, gounity.NewRaidGroupParameters(group.DiskGroup, group.NumDisks, gounity.RaidType(group.RaidType), group.StripeWidth))
+			}
+			p.upserts = append(p.upserts, Change{
+				Kind: KindPool, Name: pool.Name, Action: ActionCreate,
+				Details: []string{fmt.Sprintf("%d raid groups", len(raidGroups))},
+				apply: func(ctx context.Context) error {
+					_, err := p.client.CreateStoragePool(ctx, pool.Name, pool.Description, raidGroups)
+					return err
+				},
+			})
+		case pool.Description != "" && pool.Description != current.Description:
+			p.upserts = append(p.upserts, Change{
+				Kind: KindPool, Name: pool.Name, Action: ActionModify,
+				Details: []string{fmt.Sprintf("description %q -> %q", current.Description, pool.Description)},
+				apply: func(ctx context.Context) error {
+					return p.client.ModifyStoragePool(ctx, current.ID, types.PoolModifyParam{Description: pool.Description})
+				},
+			})
+		}
+	}
+	return nil
+}
+
+// scheduleRules converts the rules of a manifest schedule to Unity rules
+func scheduleRules(rules []ScheduleRule) []types.SnapScheduleRule {
+	converted := make([]types.SnapScheduleRule, 0, len(rules))
+	for _, rule := range rules {
+		converted = append(converted, types.SnapScheduleRule{
+			Type:          rule.Type,
+			Minute:        rule.Minute,
+			Hours:         rule.Hours,
+			DaysOfWeek:    rule.DaysOfWeek,
+			DaysOfMonth:   rule.DaysOfMonth,
+			Interval:      rule.Interval,
+			IsAutoDelete:  rule.IsAutoDelete,
+			RetentionTime: rule.RetentionTime,
+		})
+	}
+	return converted
+}
+
+// sameRules returns true if both rule lists are equal, ignoring the rule IDs
+func sameRules(a, b []types.SnapScheduleRule) bool {
+	return slices.EqualFunc(a, b, func(x, y types.SnapScheduleRule) bool {
+		return x.Type == y.Type && x.Minute == y.Minute && x.Interval == y.Interval &&
+			x.IsAutoDelete == y.IsAutoDelete && x.RetentionTime == y.RetentionTime &&
+			slices.Equal(x.Hours, y.Hours) && slices.Equal(x.DaysOfWeek, y.DaysOfWeek) && slices.Equal(x.DaysOfMonth, y.DaysOfMonth)
+	})
+}
+
+func (p *planner) planSnapshotSchedules(ctx context.Context, m *Manifest) error {
+	if len(m.SnapshotSchedules) == 0 && !slices.ContainsFunc(m.LUNs, func(lun LUN) bool { return lun.SnapshotSchedule != "" }) &&
+		!slices.ContainsFunc(m.Filesystems, func(filesystem Filesystem) bool { return filesystem.SnapshotSchedule != "" }) {
+		return nil
+	}
+	schedules, err := p.client.ListSnapshotSchedules(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to read snapshot schedules: %v", err)
+	}
+	existing := map[string]types.SnapScheduleContent{}
+	for _, schedule := range schedules {
+		existing[schedule.SnapScheduleContent.Name] = schedule.SnapScheduleContent
+		p.scheduleIDs[schedule.SnapScheduleContent.Name] = schedule.SnapScheduleContent.ID
+	}
+
+	for _, schedule := range m.SnapshotSchedules {
+		current, exists := existing[schedule.Name]
+		rules := scheduleRules(schedule.Rules)
+		switch {
+		case schedule.Absent:
+			if exists {
+				p.deletes = append(p.deletes, Change{
+					Kind: KindSnapshotSchedule, Name: schedule.Name, Action: ActionDelete, Destructive: true,
+					apply: func(ctx context.Context) error {
+						return p.client.DeleteSnapshotSchedule(ctx, current.ID)
+					},
+				})
+			}
+		case !exists:
+			p.plannedSchedules[schedule.Name] = true
+			p.upserts = append(p.upserts, Change{
+				Kind: KindSnapshotSchedule, Name: schedule.Name, Action: ActionCreate,
+				Details: []string{fmt.Sprintf("%d rules", len(rules))},
+				apply: func(ctx context.Context) error {
+					_, err := p.client.CreateSnapshotSchedule(ctx, schedule.Name, rules)
+					return err
+				},
+			})
+		case !sameRules(rules, current.Rules):
+			p.conflict("the rules of snapshot schedule %s differ from the array and cannot be modified", schedule.Name)
+		}
+	}
+	return nil
+}
+
+// scheduleDrift describes the change of the snapshot schedule of a storage resource to the wanted one, or returns
+// an empty string if the wanted schedule is already assigned
+func (p *planner) scheduleDrift(ctx context.Context, wanted, storageResourceID string) (string, error) {
+	id, ok := p.scheduleIDs[wanted]
+	if !ok && !p.plannedSchedules[wanted] {
+		return "", fmt.Errorf("snapshot schedule %s does not exist", wanted)
+	}
+	current := "none"
+	if storageResourceID != "" {
+		resource, err := p.client.FindStorageResourceByID(ctx, storageResourceID)
+		if err != nil {
+			return "", err
+		}
+		currentID := resource.StorageResourceContent.SnapSchedule.ID
+		if id != "" && id == currentID {
+			return "", nil
+		}
+		if currentID != "" {
+			current = currentID
+			for name, scheduleID := range p.scheduleIDs {
+				if scheduleID == currentID {
+					current = name
+				}
+			}
+		}
+	}
+	return fmt.Sprintf("snapshotSchedule %s -> %s", current, wanted), nil
+}
+
+// snapScheduleParameters returns the parameters assigning the snapshot schedule with the given name at apply time
+func (p *planner) snapScheduleParameters(ctx context.Context, name string) (*types.SnapScheduleParameters, error) {
+	schedules, err := p.client.ListSnapshotSchedules(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, schedule := range schedules {
+		if schedule.SnapScheduleContent.Name == name {
+			return &types.SnapScheduleParameters{SnapSchedule: &types.SnapScheduleID{ID: schedule.SnapScheduleContent.ID}}, nil
+		}
+	}
+	return nil, fmt.Errorf("snapshot schedule %s does not exist", name)
+}
+
+// hostID returns the ID of the host with the given name, or an empty ID if the host is created by the plan
+func (p *planner) hostID(ctx context.Context, name string) (string, error) {
+	if id, ok := p.hostIDs[name]; ok || p.plannedHosts[name] {
+		return id, nil
+	}
+	host, err := p.client.FindHostByName(ctx, name)
+	if err != nil {
+		return "", fmt.Errorf("unable to find host %s: %v", name, err)
+	}
+	p.hostIDs[name] = host.HostContent.ID
+	return host.HostContent.ID, nil
+}
+
+// resolveHosts returns the IDs of the hosts with the given names at apply time
+func (p *planner) resolveHosts(ctx context.Context, names []string) ([]string, error) {
+	ids := make([]string, 0, len(names))
+	for _, name := range names {
+		host, err := p.client.FindHostByName(ctx, name)
+		if err != nil {
+			return nil, fmt.Errorf("unable to find host %s: %v", name, err)
+		}
+		ids = append(ids, host.HostContent.ID)
+	}
+	return ids, nil
+}
+
+func (p *planner) planHosts(ctx context.Context, m *Manifest) error {
+	if len(m.Hosts) == 0 {
+		return nil
+	}
+	initiators, err := p.client.ListHostInitiators(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to read host initiators: %v", err)
+	}
+	existingInitiators := map[string]types.HostInitiatorContent{}
+	for _, initiator := range initiators {
+		existingInitiators[strings.ToLower(initiator.HostInitiatorContent.InitiatorID)] = initiator.HostInitiatorContent
+	}
+
+	for _, host := range m.Hosts {
+		current, err := p.client.FindHostByName(ctx, host.Name)
+		exists := err == nil
+		if err != nil && !errors.Is(err, gounity.ErrorHostNotFound) {
+			return fmt.Errorf("unable to read host %s: %v", host.Name, err)
+		}
+
+		if host.Absent {
+			if exists {
+				p.deletes = append(p.deletes, Change{
+					Kind: KindHost, Name: host.Name, Action: ActionDelete, Destructive: true,
+					apply: func(ctx context.Context) error {
+						return p.client.DeleteHost(ctx, host.Name)
+					},
+				})
+			}
+			continue
+		}
+
+		currentID := ""
+		if exists {
+			currentID = current.HostContent.ID
+			p.hostIDs[host.Name] = currentID
+		} else {
+			p.plannedHosts[host.Name] = true
+			p.upserts = append(p.upserts, Change{
+				Kind: KindHost, Name: host.Name, Action: ActionCreate,
+				apply: func(ctx context.Context) error {
+					_, err := p.client.CreateHost(ctx, host.Name, host.Tenant)
+					return err
+				},
+			})
+		}
+
+		for _, initiator := range host.Initiators {
+			action := ActionCreate
+			if existing, ok := existingInitiators[strings.ToLower(initiator.ID)]; ok {
+				parent := existing.ParentHost.ID
+				if parent != "" && parent == currentID {
+					continue
+				}
+				if parent != "" {
+					p.conflict("initiator %s of host %s is attached to host %s", initiator.ID, host.Name, parent)
+					continue
+				}
+				action = ActionModify
+			}
+			initiatorType := api.FCInitiatorType
+			if initiator.Type == InitiatorTypeISCSI {
+				initiatorType = api.ISCSCIInitiatorType
+			}
+			p.upserts = append(p.upserts, Change{
+				Kind: KindInitiator, Name: initiator.ID, Action: action,
+				Details: []string{"host " + host.Name},
+				apply: func(ctx context.Context) error {
+					hostIDs, err := p.resolveHosts(ctx, []string{host.Name})
+					if err != nil {
+						return err
+					}
+					_, err = p.client.CreateHostInitiator(ctx, hostIDs[0], initiator.ID, initiatorType)
+					return err
+				},
+			})
+		}
+	}
+	return nil
+}
+
+// accessDiff returns the host names to add and remove to go from the current hosts to the wanted ones
+func (p *planner) accessDiff(ctx context.Context, wanted []string, current []types.HostContent) (added, removed []string, err error) {
+	wantedIDs := map[string]bool{}
+	for _, name := range wanted {
+		id, err := p.hostID(ctx, name)
+		if err != nil {
+			return nil, nil, err
+		}
+		if id == "" || !slices.ContainsFunc(current, func(host types.HostContent) bool { return host.ID == id }) {
+			added = append(added, name)
+		}
+		wantedIDs[id] = true
+	}
+	for _, host := range current {
+		if !wantedIDs[host.ID] {
+			name := host.Name
+			if name == "" {
+				name = host.ID
+			}
+			removed = append(removed, name)
+		}
+	}
+	return added, removed, nil
+}
+
+// accessDetails describes a change of host access
+func accessDetails(prefix string, added, removed []string) []string {
+	var details []string
+	for _, name := range added {
+		details = append(details, prefix+"+"+name)
+	}
+	for _, name := range removed {
+		details = append(details, prefix+"-"+name)
+	}
+	return details
+}
+
+func (p *planner) planLUNs(ctx context.Context, m *Manifest) error {
+	for _, lun := range m.LUNs {
+		current, err := p.client.FindVolumeByName(ctx, lun.Name)
+		exists := err == nil
+		if err != nil && !errors.Is(err, gounity.ErrorVolumeNotFound) {
+			return fmt.Errorf("unable to read lun %s: %v", lun.Name, err)
+		}
+
+		if lun.Absent {
+			if exists {
+				p.deletes = append(p.deletes, Change{
+					Kind: KindLUN, Name: lun.Name, Action: ActionDelete, Destructive: true,
+					apply: func(ctx context.Context) error {
+						return p.client.DeleteVolume(ctx, current.VolumeContent.ResourceID)
+					},
+				})
+			}
+			continue
+		}
+
+		thin := lun.Thin == nil || *lun.Thin
+		var currentHosts []types.HostContent
+		if !exists {
+			details := []string{"pool " + lun.Pool, "size " + lun.Size.String()}
+			if lun.SnapshotSchedule != "" {
+				if _, err := p.scheduleDrift(ctx, lun.SnapshotSchedule, ""); err != nil {
+					p.conflict("lun %s: %v", lun.Name, err)
+				}
+				details = append(details, "snapshotSchedule "+lun.SnapshotSchedule)
+			}
+			p.upserts = append(p.upserts, Change{
+				Kind: KindLUN, Name: lun.Name, Action: ActionCreate,
+				Details: details,
+				apply: func(ctx context.Context) error {
+					pool, err := p.client.FindStoragePoolByName(ctx, lun.Pool)
+					if err != nil {
+						return err
+					}
+					ioLimitPolicyID := ""
+					if lun.IOLimitPolicy != "" {
+						policy, err := p.client.FindHostIOLimitByName(ctx, lun.IOLimitPolicy)
+						if err != nil {
+							return err
+						}
+						ioLimitPolicyID = policy.IoLimitPolicyContent.ID
+					}
+					volume, err := p.client.CreateLun(ctx, lun.Name, pool.StoragePoolContent.ID, lun.Description, uint64(lun.Size),
+						tieringPolicy(lun.TieringPolicy), ioLimitPolicyID, thin, lun.DataReduction)
+					if err != nil || lun.SnapshotSchedule == "" {
+						return err
+					}
+					schedule, err := p.snapScheduleParameters(ctx, lun.SnapshotSchedule)
+					if err != nil {
+						return err
+					}
+					return p.client.ModifyVolume(ctx, volume.VolumeContent.ResourceID, types.VolumeModifyParam{SnapScheduleParameters: schedule})
+				},
+			})
+		} else {
+			content := current.VolumeContent
+			for _, access := range content.HostAccessResponse {
+				currentHosts = append(currentHosts, access.HostContent)
+			}
+			if p.poolIDs[lun.Pool] != content.Pool.ID {
+				p.conflict("lun %s is in pool %s and cannot be moved to pool %s", lun.Name, content.Pool.ID, lun.Pool)
+			}
+			if thin != content.IsThinEnabled || lun.DataReduction != content.IsDataReductionEnabled {
+				p.conflict("thin provisioning and data reduction of lun %s cannot be changed", lun.Name)
+			}
+			switch size := uint64(lun.Size); {
+			case size < content.SizeTotal:
+				p.conflict("lun %s cannot be shrunk from %s to %s", lun.Name, Size(content.SizeTotal), lun.Size)
+			case size > content.SizeTotal:
+				p.upserts = append(p.upserts, Change{
+					Kind: KindLUN, Name: lun.Name, Action: ActionModify,
+					Details: []string{fmt.Sprintf("size %s -> %s", Size(content.SizeTotal), lun.Size)},
+					apply: func(ctx context.Context) error {
+						return p.client.ExpandVolume(ctx, content.ResourceID, size)
+					},
+				})
+			}
+			p.planLUNModify(ctx, lun, content)
+		}
+
+		if lun.Hosts == nil {
+			continue
+		}
+		added, removed, err := p.accessDiff(ctx, lun.Hosts, currentHosts)
+		if err != nil {
+			p.conflict("host access of lun %s: %v", lun.Name, err)
+			continue
+		}
+		if len(added) == 0 && len(removed) == 0 {
+			continue
+		}
+		p.access = append(p.access, Change{
+			Kind: KindHostAccess, Name: string(KindLUN) + "/" + lun.Name, Action: ActionModify,
+			Details: accessDetails("", added, removed), Destructive: len(removed) > 0,
+			apply: func(ctx context.Context) error {
+				volume, err := p.client.FindVolumeByName(ctx, lun.Name)
+				if err != nil {
+					return err
+				}
+				hostIDs, err := p.resolveHosts(ctx, lun.Hosts)
+				if err != nil {
+					return err
+				}
+				return p.client.ModifyVolumeExport(ctx, volume.VolumeContent.ResourceID, hostIDs)
+			},
+		})
+	}
+	return nil
+}
+
+// tieringPolicy returns the FAST VP tiering policy of a manifest, 0 if it is not set
+func tieringPolicy(policy *int) int {
+	if policy == nil {
+		return 0
+	}
+	return *policy
+}
+
+// planLUNModify plans the modification of the description, tiering policy, host I/O limit policy and snapshot
+// schedule of an existing LUN
+func (p *planner) planLUNModify(ctx context.Context, lun LUN, content types.VolumeContent) {
+	var details []string
+	params := types.VolumeModifyParam{}
+	lunParams := &types.LunParameters{}
+	if lun.Description != "" && lun.Description != content.Description {
+		details = append(details, fmt.Sprintf("description %q -> %q", content.Description, lun.Description))
+		params.Description = lun.Description
+	}
+	if lun.TieringPolicy != nil && *lun.TieringPolicy != content.TieringPolicy {
+		details = append(details, fmt.Sprintf("tieringPolicy %d -> %d", content.TieringPolicy, *lun.TieringPolicy))
+		lunParams.FastVPParameters = &types.FastVPParameters{TieringPolicy: *lun.TieringPolicy}
+	}
+	if lun.IOLimitPolicy != "" {
+		policy, err := p.client.FindHostIOLimitByName(ctx, lun.IOLimitPolicy)
+		switch {
+		case err != nil:
+			p.conflict("ioLimitPolicy of lun %s: %v", lun.Name, err)
+		case policy.IoLimitPolicyContent.ID != content.IoLimitPolicyContent.ID:
+			current := content.IoLimitPolicyContent.ID
+			if current == "" {
+				current = "none"
+			}
+			details = append(details, fmt.Sprintf("ioLimitPolicy %s -> %s", current, lun.IOLimitPolicy))
+			lunParams.IoLimitParameters = &types.HostIoLimitParameters{IoLimitPolicyParam: &types.IoLimitPolicyParam{ID: policy.IoLimitPolicyContent.ID}}
+		}
+	}
+	if lunParams.FastVPParameters != nil || lunParams.IoLimitParameters != nil {
+		params.LunParameters = lunParams
+	}
+	assignSchedule := false
+	if lun.SnapshotSchedule != "" {
+		drift, err := p.scheduleDrift(ctx, lun.SnapshotSchedule, content.ResourceID)
+		switch {
+		case err != nil:
+			p.conflict("snapshotSchedule of lun %s: %v", lun.Name, err)
+		case drift != "":
+			details = append(details, drift)
+			assignSchedule = true
+		}
+	}
+	if len(details) == 0 {
+		return
+	}
+	p.upserts = append(p.upserts, Change{
+		Kind: KindLUN, Name: lun.Name, Action: ActionModify,
+		Details: details,
+		apply: func(ctx context.Context) error {
+			if assignSchedule {
+				schedule, err := p.snapScheduleParameters(ctx, lun.SnapshotSchedule)
+				if err != nil {
+					return err
+				}
+				params.SnapScheduleParameters = schedule
+			}
+			return p.client.ModifyVolume(ctx, content.ResourceID, params)
+		},
+	})
+}
+
+func (p *planner) planFilesystems(ctx context.Context, m *Manifest) error {
+	for _, filesystem := range m.Filesystems {
+		current, err := p.client.FindFilesystemByName(ctx, filesystem.Name)
+		exists := err == nil
+		if err != nil && !errors.Is(err, gounity.ErrorFilesystemNotFound) {
+			return fmt.Errorf("unable to read filesystem %s: %v", filesystem.Name, err)
+		}
+
+		if filesystem.Absent {
+			if exists {
+				p.deletes = append(p.deletes, Change{
+					Kind: KindFilesystem, Name: filesystem.Name, Action: ActionDelete, Destructive: true,
+					apply: func(ctx context.Context) error {
+						return p.client.DeleteFilesystem(ctx, current.FileContent.ID)
+					},
+				})
+			}
+			continue
+		}
+
+		thin := filesystem.Thin == nil || *filesystem.Thin
+		hostIOSize := filesystem.HostIOSize
+		if hostIOSize == 0 {
+			hostIOSize = defaultHostIOSize
+		}
+		if !exists {
+			details := []string{"pool " + filesystem.Pool, "size " + filesystem.Size.String()}
+			if filesystem.SnapshotSchedule != "" {
+				if _, err := p.scheduleDrift(ctx, filesystem.SnapshotSchedule, ""); err != nil {
+					p.conflict("filesystem %s: %v", filesystem.Name, err)
+				}
+				details = append(details, "snapshotSchedule "+filesystem.SnapshotSchedule)
+			}
+			p.upserts = append(p.upserts, Change{
+				Kind: KindFilesystem, Name: filesystem.Name, Action: ActionCreate,
+				Details: details,
+				apply: func(ctx context.Context) error {
+					pool, err := p.client.FindStoragePoolByName(ctx, filesystem.Pool)
+					if err != nil {
+						return err
+					}
+					created, err := p.client.CreateFilesystem(ctx, filesystem.Name, pool.StoragePoolContent.ID, filesystem.Description, filesystem.NASServer,
+						uint64(filesystem.Size), tieringPolicy(filesystem.TieringPolicy), hostIOSize, 0, thin, filesystem.DataReduction)
+					if err != nil || filesystem.SnapshotSchedule == "" {
+						return err
+					}
+					schedule, err := p.snapScheduleParameters(ctx, filesystem.SnapshotSchedule)
+					if err != nil {
+						return err
+					}
+					return p.client.ModifyFilesystem(ctx, created.FileContent.ID, types.FsModifyParameters{SnapScheduleParameters: schedule})
+				},
+			})
+			continue
+		}
+
+		content := current.FileContent
+		p.filesystemIDs[filesystem.Name] = content.ID
+		if p.poolIDs[filesystem.Pool] != content.Pool.ID {
+			p.conflict("filesystem %s is in pool %s and cannot be moved to pool %s", filesystem.Name, content.Pool.ID, filesystem.Pool)
+		}
+		if filesystem.NASServer != content.NASServer.ID {
+			p.conflict("filesystem %s is served by NAS server %s and cannot be moved to %s", filesystem.Name, content.NASServer.ID, filesystem.NASServer)
+		}
+		if thin != content.IsThinEnabled || filesystem.DataReduction != content.IsDataReductionEnabled || int64(hostIOSize) != content.HostIOSize {
+			p.conflict("thin provisioning, data reduction and host I/O size of filesystem %s cannot be changed", filesystem.Name)
+		}
+		switch size := uint64(filesystem.Size); {
+		case size < content.SizeTotal:
+			p.conflict("filesystem %s cannot be shrunk from %s to %s", filesystem.Name, Size(content.SizeTotal), filesystem.Size)
+		case size > content.SizeTotal:
+			p.upserts = append(p.upserts, Change{
+				Kind: KindFilesystem, Name: filesystem.Name, Action: ActionModify,
+				Details: []string{fmt.Sprintf("size %s -> %s", Size(content.SizeTotal), filesystem.Size)},
+				apply: func(ctx context.Context) error {
+					return p.client.ExpandFilesystem(ctx, content.ID, size)
+				},
+			})
+		}
+		p.planFilesystemModify(ctx, filesystem, content)
+	}
+	return nil
+}
+
+// planFilesystemModify plans the modification of the description, tiering policy and snapshot schedule of an
+// existing filesystem
+func (p *planner) planFilesystemModify(ctx context.Context, filesystem Filesystem, content types.FileContent) {
+	var details []string
+	params := types.FsModifyParameters{}
+	if filesystem.Description != "" && filesystem.Description != content.Description {
+		details = append(details, fmt.Sprintf("description %q -> %q", content.Description, filesystem.Description))
+		params.Description = filesystem.Description
+	}
+	if filesystem.TieringPolicy != nil && uint64(*filesystem.TieringPolicy) != content.TieringPolicy {
+		details = append(details, fmt.Sprintf("tieringPolicy %d -> %d", content.TieringPolicy, *filesystem.TieringPolicy))
+		params.FsParameters = &types.FsFastVPModifyParameters{FastVPParameters: &types.FastVPParameters{TieringPolicy: *filesystem.TieringPolicy}}
+	}
+	assignSchedule := false
+	if filesystem.SnapshotSchedule != "" {
+		drift, err := p.scheduleDrift(ctx, filesystem.SnapshotSchedule, content.StorageResource.ID)
+		switch {
+		case err != nil:
+			p.conflict("snapshotSchedule of filesystem %s: %v", filesystem.Name, err)
+		case drift != "":
+			details = append(details, drift)
+			assignSchedule = true
+		}
+	}
+	if len(details) == 0 {
+		return
+	}
+	p.upserts = append(p.upserts, Change{
+		Kind: KindFilesystem, Name: filesystem.Name, Action: ActionModify,
+		Details: details,
+		apply: func(ctx context.Context) error {
+			if assignSchedule {
+				schedule, err := p.snapScheduleParameters(ctx, filesystem.SnapshotSchedule)
+				if err != nil {
+					return err
+				}
+				params.SnapScheduleParameters = schedule
+			}
+			return p.client.ModifyFilesystem(ctx, content.ID, params)
+		},
+	})
+}
+
+// shareAccess is a host list of an NFS share
+type shareAccess struct {
+	field      string
+	accessType gounity.AccessType
+	wanted     []string
+	current    []types.HostContent
+}
+
+func (p *planner) planNFSShares(ctx context.Context, m *Manifest) error {
+	for _, share := range m.NFSShares {
+		current, err := p.client.FindNFSShareByName(ctx, share.Name)
+		exists := err == nil
+		if err != nil && !errors.Is(err, gounity.ErrorNFSShareNotFound) {
+			return fmt.Errorf("unable to read nfsShare %s: %v", share.Name, err)
+		}
+
+		if share.Absent {
+			if exists {
+				p.deletes = append(p.deletes, Change{
+					Kind: KindNFSShare, Name: share.Name, Action: ActionDelete, Destructive: true,
+					apply: func(ctx context.Context) error {
+						return p.client.DeleteNFSShare(ctx, current.NFSShareContent.Filesystem.ID, current.NFSShareContent.ID)
+					},
+				})
+			}
+			continue
+		}
+
+		accesses := []shareAccess{
+			{field: "readOnlyHosts", accessType: gounity.ReadOnlyAccessType, wanted: share.ReadOnlyHosts},
+			{field: "readWriteHosts", accessType: gounity.ReadWriteAccessType, wanted: share.ReadWriteHosts},
+			{field: "readOnlyRootHosts", accessType: gounity.ReadOnlyRootAccessType, wanted: share.ReadOnlyRootHosts},
+			{field: "rootHosts", accessType: gounity.ReadWriteRootAccessType, wanted: share.RootHosts},
+		}
+		if !exists {
+			path := share.Path
+			if path == "" {
+				path = "/"
+			}
+			p.upserts = append(p.upserts, Change{
+				Kind: KindNFSShare, Name: share.Name, Action: ActionCreate,
+				Details: []string{"filesystem " + share.Filesystem},
+				apply: func(ctx context.Context) error {
+					filesystem, err := p.client.FindFilesystemByName(ctx, share.Filesystem)
+					if err != nil {
+						return err
+					}
+					_, err = p.client.CreateNFSShare(ctx, share.Name, path, filesystem.FileContent.ID, defaultAccesses[share.DefaultAccess])
+					return err
+				},
+			})
+		} else {
+			content := current.NFSShareContent
+			if id, ok := p.filesystemIDs[share.Filesystem]; !ok || id != content.Filesystem.ID {
+				if !ok {
+					if filesystem, err := p.client.FindFilesystemByName(ctx, share.Filesystem); err == nil {
+						id, ok = filesystem.FileContent.ID, true
+					}
+				}
+				if !ok || id != content.Filesystem.ID {
+					p.conflict("nfsShare %s exports filesystem %s and cannot be moved to filesystem %s", share.Name, content.Filesystem.ID, share.Filesystem)
+				}
+			}
+			accesses[0].current = content.ReadOnlyHosts
+			accesses[1].current = content.ReadWriteHosts
+			accesses[2].current = content.ReadOnlyRootAccessHosts
+			accesses[3].current = content.RootAccessHosts
+		}
+
+		var (
+			details     []string
+			changed     []shareAccess
+			destructive bool
+		)
+		for _, access := range accesses {
+			if access.wanted == nil {
+				continue
+			}
+			added, removed, err := p.accessDiff(ctx, access.wanted, access.current)
+			if err != nil {
+				p.conflict("host access of nfsShare %s: %v", share.Name, err)
+				changed = nil
+				break
+			}
+			if len(added) == 0 && len(removed) == 0 {
+				continue
+			}
+			details = append(details, accessDetails(access.field+" ", added, removed)...)
+			changed = append(changed, access)
+			destructive = destructive || len(removed) > 0
+		}
+		if len(changed) == 0 {
+			continue
+		}
+		p.access = append(p.access, Change{
+			Kind: KindHostAccess, Name: string(KindNFSShare) + "/" + share.Name, Action: ActionModify,
+			Details: details, Destructive: destructive,
+			apply: func(ctx context.Context) error {
+				nfsShare, err := p.client.FindNFSShareByName(ctx, share.Name)
+				if err != nil {
+					return err
+				}
+				for _, access := range changed {
+					hostIDs, err := p.resolveHosts(ctx, access.wanted)
+					if err != nil {
+						return err
+					}
+					err = p.client.ModifyNFSShareHostAccess(ctx, nfsShare.NFSShareContent.Filesystem.ID, nfsShare.NFSShareContent.ID, hostIDs, access.accessType)
+					if err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+		})
+	}
+	return nil
+}
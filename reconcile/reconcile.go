@@ -0,0 +1,136 @@
+/*
+ Copyright © 2025 Dell Inc. or its subsidiaries. All Rights Reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+// Package reconcile brings a Unity array to the state described by a declarative manifest of pools,
+// snapshot schedules, hosts, LUNs, filesystems and NFS shares.
+package reconcile
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/dell/gounity"
+	util "github.com/dell/gounity/gounityutil"
+)
+
+var (
+	// ErrConflicts is returned when applying a plan with conflicts
+	ErrConflicts = errors.New("plan has conflicts which cannot be reconciled")
+	// ErrDestructiveChanges is returned when applying a plan with destructive changes which are not allowed
+	ErrDestructiveChanges = errors.New("plan has destructive changes which are not allowed")
+)
+
+// Options of a Reconciler
+type Options struct {
+	// DryRun plans the changes without applying them
+	DryRun bool
+	// AllowDestructive allows deletions and removals of host access. Without it, a plan with destructive changes is not applied.
+	AllowDestructive bool
+	// ContinueOnError applies the remaining changes after a change failed, instead of skipping them
+	ContinueOnError bool
+}
+
+// Status is the outcome of a change
+type Status string
+
+// Status constants
+const (
+	StatusApplied Status = "applied"
+	StatusFailed  Status = "failed"
+	StatusSkipped Status = "skipped"
+	StatusPlanned Status = "planned"
+)
+
+// Result is the outcome of applying a change
+type Result struct {
+	Change Change
+	Status Status
+	Err    error
+}
+
+// Reconciler plans and applies the changes bringing an array to the state of a manifest
+type Reconciler struct {
+	client  gounity.UnityClient
+	options Options
+}
+
+// NewReconciler returns a reconciler using the client
+func NewReconciler(client gounity.UnityClient, options Options) *Reconciler {
+	return &Reconciler{client: client, options: options}
+}
+
+// Plan compares the manifest with the array and returns the changes to apply, without changing the array
+func (r *Reconciler) Plan(ctx context.Context, m *Manifest) (*Plan, error) {
+	if err := m.Validate(); err != nil {
+		return nil, err
+	}
+	return newPlanner(r.client).plan(ctx, m)
+}
+
+// Apply applies the changes of the plan in order and returns the result of every change. Changes after a failed
+// one are skipped unless ContinueOnError is set. With DryRun, every change is reported as planned.
+func (r *Reconciler) Apply(ctx context.Context, plan *Plan) ([]Result, error) {
+	log := util.GetRunIDLogger(ctx)
+	if len(plan.Conflicts) > 0 {
+		return nil, fmt.Errorf("%w: %v", ErrConflicts, plan.Conflicts)
+	}
+	if destructive := plan.Destructive(); len(destructive) > 0 && !r.options.AllowDestructive && !r.options.DryRun {
+		return nil, fmt.Errorf("%w: %d destructive changes", ErrDestructiveChanges, len(destructive))
+	}
+
+	results := make([]Result, 0, len(plan.Changes))
+	failed := 0
+	for _, change := range plan.Changes {
+		switch {
+		case r.options.DryRun:
+			log.Infof("Planned: %s", change)
+			results = append(results, Result{Change: change, Status: StatusPlanned})
+			continue
+		case ctx.Err() != nil:
+			results = append(results, Result{Change: change, Status: StatusSkipped, Err: ctx.Err()})
+			continue
+		case failed > 0 && !r.options.ContinueOnError:
+			results = append(results, Result{Change: change, Status: StatusSkipped})
+			continue
+		}
+
+		if err := change.apply(ctx); err != nil {
+			log.Errorf("Unable to %s: %v", change, err)
+			failed++
+			results = append(results, Result{Change: change, Status: StatusFailed, Err: err})
+			continue
+		}
+		log.Infof("Applied: %s", change)
+		results = append(results, Result{Change: change, Status: StatusApplied})
+	}
+
+	if failed > 0 {
+		return results, fmt.Errorf("%d of %d changes failed", failed, len(plan.Changes))
+	}
+	if err := ctx.Err(); err != nil && !r.options.DryRun {
+		return results, err
+	}
+	return results, nil
+}
+
+// Reconcile plans and applies the changes bringing the array to the state of the manifest
+func (r *Reconciler) Reconcile(ctx context.Context, m *Manifest) (*Plan, []Result, error) {
+	plan, err := r.Plan(ctx, m)
+	if err != nil {
+		return nil, nil, err
+	}
+	results, err := r.Apply(ctx, plan)
+	return plan, results, err
+}
@@ -0,0 +1,287 @@
+/*
+ Copyright © 2025 Dell Inc. or its subsidiaries. All Rights Reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package reconcile
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/dell/gounity"
+	"github.com/dell/gounity/api"
+	types "github.com/dell/gounity/apitypes"
+	"github.com/dell/gounity/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// fakeArray is a mock client whose hosts are looked up by name in a map, so that created hosts are found
+type fakeArray struct {
+	*mocks.UnityClient
+	hosts map[string]string
+}
+
+func (f *fakeArray) FindHostByName(_ context.Context, hostName string) (*types.Host, error) {
+	id, ok := f.hosts[hostName]
+	if !ok {
+		return nil, gounity.ErrorHostNotFound
+	}
+	return &types.Host{HostContent: types.HostContent{ID: id, Name: hostName}}, nil
+}
+
+// arrayClient returns a client of an array with pool1, host1 and the LUN vol1 of 10Gi exported to host1
+func arrayClient() *fakeArray {
+	client := &fakeArray{UnityClient: &mocks.UnityClient{}, hosts: map[string]string{"host1": "Host_1"}}
+	host1 := types.HostContent{ID: "Host_1", Name: "host1"}
+	client.On("ListStoragePools", mock.Anything).Return([]types.StoragePool{
+		{StoragePoolContent: types.StoragePoolContent{ID: "pool_1", Name: "pool1"}},
+	}, nil)
+	client.On("ListHostInitiators", mock.Anything).Return([]types.HostInitiator{
+		{HostInitiatorContent: types.HostInitiatorContent{ID: "HostInitiator_1", InitiatorID: "IQN.A", ParentHost: host1}},
+	}, nil)
+	client.On("FindVolumeByName", mock.Anything, "vol1").Return(&types.Volume{VolumeContent: types.VolumeContent{
+		ResourceID: "sv_1", Name: "vol1", SizeTotal: 10 << 30, IsThinEnabled: true, Pool: types.Pool{ID: "pool_1"},
+		HostAccessResponse: []types.HostAccessResponse{{HostContent: host1}},
+	}}, nil)
+	client.On("FindVolumeByName", mock.Anything, mock.Anything).Return(nil, gounity.ErrorVolumeNotFound)
+	return client
+}
+
+func TestPlan(t *testing.T) {
+	ctx := context.Background()
+	client := arrayClient()
+	client.On("FindFilesystemByName", mock.Anything, "fs1").Return(nil, gounity.ErrorFilesystemNotFound)
+	client.On("FindNFSShareByName", mock.Anything, "share1").Return(nil, gounity.ErrorNFSShareNotFound)
+
+	manifest := &Manifest{
+		Hosts: []Host{
+			{Name: "host1", Initiators: []Initiator{{ID: "iqn.a", Type: InitiatorTypeISCSI}}},
+			{Name: "host2", Initiators: []Initiator{{ID: "10:00:00:00:c9:00:00:01", Type: InitiatorTypeFC}}},
+		},
+		LUNs: []LUN{
+			{Name: "vol1", Pool: "pool1", Size: 20 << 30, Hosts: []string{"host2"}},
+			{Name: "vol2", Pool: "pool1", Size: 1 << 30},
+		},
+		Filesystems: []Filesystem{{Name: "fs1", Pool: "pool1", NASServer: "nas_1", Size: 5 << 30}},
+		NFSShares:   []NFSShare{{Name: "share1", Filesystem: "fs1", ReadWriteHosts: []string{"host1"}}},
+	}
+	plan, err := NewReconciler(client, Options{}).Plan(ctx, manifest)
+	assert.NoError(t, err)
+	assert.Empty(t, plan.Conflicts)
+
+	var changes []string
+	for _, change := range plan.Changes {
+		changes = append(changes, change.String())
+	}
+	assert.Equal(t, []string{
+		"create host host2",
+		"create initiator 10:00:00:00:c9:00:00:01 (host host2)",
+		"modify lun vol1 (size 10Gi -> 20Gi)",
+		"create lun vol2 (pool pool1, size 1Gi)",
+		"create filesystem fs1 (pool pool1, size 5Gi)",
+		"create nfsShare share1 (filesystem fs1)",
+		"modify hostAccess lun/vol1 (+host2, -host1)",
+		"modify hostAccess nfsShare/share1 (readWriteHosts +host1)",
+	}, changes)
+	assert.Len(t, plan.Destructive(), 1)
+
+	// Negative cases
+	plan, err = NewReconciler(client, Options{}).Plan(ctx, &Manifest{
+		Pools: []Pool{{Name: "pool2"}},
+		Hosts: []Host{{Name: "host3", Initiators: []Initiator{{ID: "iqn.a", Type: InitiatorTypeISCSI}}}},
+		LUNs: []LUN{
+			{Name: "vol1", Pool: "pool1", Size: 1 << 30, DataReduction: true},
+			{Name: "vol3", Pool: "pool1", Size: 1 << 30, Hosts: []string{"unknown"}},
+		},
+	})
+	assert.NoError(t, err)
+	assert.Len(t, plan.Conflicts, 5)
+	assert.Contains(t, plan.Conflicts, "pool pool2 does not exist and has no raid groups to create it from")
+	assert.Contains(t, plan.Conflicts, "initiator iqn.a of host host3 is attached to host Host_1")
+	assert.Contains(t, plan.Conflicts, "lun vol1 cannot be shrunk from 10Gi to 1Gi")
+
+	_, err = NewReconciler(client, Options{}).Plan(ctx, &Manifest{LUNs: []LUN{{Name: "vol1"}}})
+	assert.ErrorContains(t, err, "invalid manifest")
+
+	failing := &mocks.UnityClient{}
+	failing.On("ListStoragePools", mock.Anything).Return(nil, errors.New("connection refused"))
+	_, err = NewReconciler(failing, Options{}).Plan(ctx, manifest)
+	assert.ErrorContains(t, err, "unable to read pools")
+}
+
+func TestPlanModify(t *testing.T) {
+	ctx := context.Background()
+	client := arrayClient()
+	client.On("ListSnapshotSchedules", mock.Anything).Return([]types.SnapSchedule{
+		{SnapScheduleContent: types.SnapScheduleContent{ID: "snapSch_1", Name: "daily"}},
+		{SnapScheduleContent: types.SnapScheduleContent{ID: "snapSch_2", Name: "hourly"}},
+	}, nil)
+	client.On("FindHostIOLimitByName", mock.Anything, "gold").Return(&types.IoLimitPolicy{IoLimitPolicyContent: types.IoLimitPolicyContent{ID: "qp_1", Name: "gold"}}, nil)
+	client.On("FindStorageResourceByID", mock.Anything, "sv_1").Return(&types.StorageResourceParameters{}, nil)
+	client.On("FindStorageResourceByID", mock.Anything, "res_1").Return(&types.StorageResourceParameters{
+		StorageResourceContent: types.StorageResourceContent{SnapSchedule: types.StorageResource{ID: "snapSch_2"}},
+	}, nil)
+	client.On("FindFilesystemByName", mock.Anything, "fs1").Return(&types.Filesystem{FileContent: types.FileContent{
+		ID: "fs_1", Description: "old", SizeTotal: 5 << 30, IsThinEnabled: true, HostIOSize: defaultHostIOSize, TieringPolicy: 1,
+		Pool: types.Pool{ID: "pool_1"}, NASServer: types.Pool{ID: "nas_1"}, StorageResource: types.Pool{ID: "res_1"},
+	}}, nil)
+
+	tiering := 2
+	manifest := &Manifest{
+		LUNs: []LUN{{Name: "vol1", Pool: "pool1", Size: 10 << 30, Description: "db", TieringPolicy: &tiering, IOLimitPolicy: "gold", SnapshotSchedule: "daily"}},
+		Filesystems: []Filesystem{
+			{Name: "fs1", Pool: "pool1", NASServer: "nas_1", Size: 5 << 30, Description: "new", SnapshotSchedule: "daily"},
+		},
+	}
+	plan, err := NewReconciler(client, Options{}).Plan(ctx, manifest)
+	assert.NoError(t, err)
+	assert.Empty(t, plan.Conflicts)
+	var changes []string
+	for _, change := range plan.Changes {
+		changes = append(changes, change.String())
+	}
+	assert.Equal(t, []string{
+		`modify lun vol1 (description "" -> "db", tieringPolicy 0 -> 2, ioLimitPolicy none -> gold, snapshotSchedule none -> daily)`,
+		`modify filesystem fs1 (description "old" -> "new", snapshotSchedule hourly -> daily)`,
+	}, changes)
+
+	schedule := &types.SnapScheduleParameters{SnapSchedule: &types.SnapScheduleID{ID: "snapSch_1"}}
+	client.On("ModifyVolume", mock.Anything, "sv_1", types.VolumeModifyParam{
+		Description: "db",
+		LunParameters: &types.LunParameters{
+			FastVPParameters:  &types.FastVPParameters{TieringPolicy: 2},
+			IoLimitParameters: &types.HostIoLimitParameters{IoLimitPolicyParam: &types.IoLimitPolicyParam{ID: "qp_1"}},
+		},
+		SnapScheduleParameters: schedule,
+	}).Return(nil).Once()
+	client.On("ModifyFilesystem", mock.Anything, "fs_1", types.FsModifyParameters{Description: "new", SnapScheduleParameters: schedule}).Return(nil).Once()
+	results, err := NewReconciler(client, Options{}).Apply(ctx, plan)
+	assert.NoError(t, err)
+	assert.Len(t, results, 2)
+	client.AssertNumberOfCalls(t, "ModifyVolume", 1)
+	client.AssertNumberOfCalls(t, "ModifyFilesystem", 1)
+
+	// A LUN created with a schedule gets it assigned after its creation
+	client.On("FindStoragePoolByName", mock.Anything, "pool1").Return(&types.StoragePool{StoragePoolContent: types.StoragePoolContent{ID: "pool_1"}}, nil)
+	client.On("CreateLun", mock.Anything, "vol2", "pool_1", "", uint64(1<<30), 0, "", true, false).Return(&types.Volume{VolumeContent: types.VolumeContent{ResourceID: "sv_2"}}, nil).Once()
+	client.On("ModifyVolume", mock.Anything, "sv_2", types.VolumeModifyParam{SnapScheduleParameters: schedule}).Return(nil).Once()
+	plan, err = NewReconciler(client, Options{}).Plan(ctx, &Manifest{LUNs: []LUN{{Name: "vol2", Pool: "pool1", Size: 1 << 30, SnapshotSchedule: "daily"}}})
+	assert.NoError(t, err)
+	assert.Equal(t, "create lun vol2 (pool pool1, size 1Gi, snapshotSchedule daily)", plan.Changes[0].String())
+	_, err = NewReconciler(client, Options{}).Apply(ctx, plan)
+	assert.NoError(t, err)
+	client.AssertNumberOfCalls(t, "ModifyVolume", 2)
+
+	// Negative cases
+	plan, err = NewReconciler(client, Options{}).Plan(ctx, &Manifest{
+		LUNs: []LUN{{Name: "vol1", Pool: "pool1", Size: 10 << 30, SnapshotSchedule: "weekly"}},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"snapshotSchedule of lun vol1: snapshot schedule weekly does not exist"}, plan.Conflicts)
+
+	_, err = NewReconciler(client, Options{}).Plan(ctx, &Manifest{
+		SnapshotSchedules: []SnapshotSchedule{{Name: "daily", Absent: true}},
+		LUNs:              []LUN{{Name: "vol1", Pool: "pool1", Size: 10 << 30, SnapshotSchedule: "daily"}},
+	})
+	assert.ErrorContains(t, err, "lun vol1 uses snapshot schedule daily which is absent")
+
+	failing := arrayClient()
+	failing.ExpectedCalls = nil
+	failing.On("ListStoragePools", mock.Anything).Return(nil, nil)
+	failing.On("FindVolumeByName", mock.Anything, "vol1").Return(nil, errors.New("unable to find volume by name vol1"))
+	failing.On("FindNFSShareByName", mock.Anything, "share1").Return(nil, errors.New("connection refused"))
+	_, err = NewReconciler(failing, Options{}).Plan(ctx, &Manifest{LUNs: []LUN{{Name: "vol1", Pool: "pool1", Size: 1 << 30}}})
+	assert.EqualError(t, err, "unable to read lun vol1: unable to find volume by name vol1")
+	_, err = NewReconciler(failing, Options{}).Plan(ctx, &Manifest{NFSShares: []NFSShare{{Name: "share1", Filesystem: "fs1"}}})
+	assert.EqualError(t, err, "unable to read nfsShare share1: connection refused")
+}
+
+func TestApply(t *testing.T) {
+	ctx := context.Background()
+	manifest := &Manifest{
+		Hosts: []Host{{Name: "host2", Initiators: []Initiator{{ID: "10:00", Type: InitiatorTypeFC}}}},
+		LUNs: []LUN{
+			{Name: "vol1", Pool: "pool1", Size: 10 << 30, Hosts: []string{"host1", "host2"}},
+			{Name: "old", Absent: true},
+		},
+	}
+
+	newClient := func() *fakeArray {
+		client := arrayClient()
+		client.On("FindVolumeByName", mock.Anything, "old").Return(&types.Volume{VolumeContent: types.VolumeContent{ResourceID: "sv_2"}}, nil)
+		// the expectation of vol1 and the catch-all come after the one of old
+		calls := client.ExpectedCalls
+		client.ExpectedCalls = append([]*mock.Call{calls[len(calls)-1]}, calls[:len(calls)-1]...)
+		return client
+	}
+
+	// Deleting is refused unless allowed
+	client := newClient()
+	_, _, err := NewReconciler(client, Options{}).Reconcile(ctx, manifest)
+	assert.ErrorIs(t, err, ErrDestructiveChanges)
+	client.AssertNotCalled(t, "CreateHost", mock.Anything, mock.Anything, mock.Anything)
+
+	// Dry run plans every change
+	plan, results, err := NewReconciler(client, Options{DryRun: true}).Reconcile(ctx, manifest)
+	assert.NoError(t, err)
+	assert.Len(t, results, len(plan.Changes))
+	for _, result := range results {
+		assert.Equal(t, StatusPlanned, result.Status)
+	}
+	client.AssertNotCalled(t, "CreateHost", mock.Anything, mock.Anything, mock.Anything)
+
+	client = newClient()
+	client.On("CreateHost", mock.Anything, "host2", "").Return(&types.Host{HostContent: types.HostContent{ID: "Host_2"}}, nil).Run(func(mock.Arguments) {
+		client.hosts["host2"] = "Host_2"
+	})
+	client.On("CreateHostInitiator", mock.Anything, "Host_2", "10:00", api.FCInitiatorType).Return(&types.HostInitiator{}, nil)
+	client.On("ModifyVolumeExport", mock.Anything, "sv_1", []string{"Host_1", "Host_2"}).Return(nil)
+	client.On("DeleteVolume", mock.Anything, "sv_2").Return(nil)
+	_, results, err = NewReconciler(client, Options{AllowDestructive: true}).Reconcile(ctx, manifest)
+	assert.NoError(t, err)
+	assert.Len(t, results, 4)
+	for _, result := range results {
+		assert.Equal(t, StatusApplied, result.Status, result.Change.String())
+	}
+	assert.Equal(t, ActionDelete, results[3].Change.Action)
+	client.AssertExpectations(t)
+
+	// Negative cases
+	client = newClient()
+	client.On("CreateHost", mock.Anything, "host2", "").Return(nil, errors.New("host exists"))
+	_, results, err = NewReconciler(client, Options{AllowDestructive: true}).Reconcile(ctx, manifest)
+	assert.EqualError(t, err, "1 of 4 changes failed")
+	assert.Equal(t, StatusFailed, results[0].Status)
+	assert.Equal(t, StatusSkipped, results[1].Status)
+	assert.Equal(t, StatusSkipped, results[3].Status)
+	client.AssertNotCalled(t, "DeleteVolume", mock.Anything, mock.Anything)
+
+	client.On("CreateHostInitiator", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("unexpected"))
+	client.On("DeleteVolume", mock.Anything, "sv_2").Return(nil)
+	_, results, err = NewReconciler(client, Options{AllowDestructive: true, ContinueOnError: true}).Reconcile(ctx, manifest)
+	assert.EqualError(t, err, "3 of 4 changes failed")
+	assert.Equal(t, StatusApplied, results[3].Status)
+
+	cancelled, cancel := context.WithCancel(ctx)
+	plan, err = NewReconciler(client, Options{}).Plan(ctx, manifest)
+	assert.NoError(t, err)
+	cancel()
+	results, err = NewReconciler(client, Options{AllowDestructive: true}).Apply(cancelled, plan)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, StatusSkipped, results[0].Status)
+
+	_, err = NewReconciler(client, Options{}).Apply(ctx, &Plan{Conflicts: []string{"lun vol1 cannot be shrunk"}})
+	assert.ErrorIs(t, err, ErrConflicts)
+}
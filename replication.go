@@ -0,0 +1,35 @@
+/*
+ Copyright © 2025 Dell Inc. or its subsidiaries. All Rights Reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package gounity
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/dell/gounity/api"
+	types "github.com/dell/gounity/apitypes"
+)
+
+// ListReplicationSessions lists the replication sessions of the array
+// - Example: GET /api/types/replicationSession/instances?fields=id,name,replicationResourceType,status,srcResourceId,dstResourceId
+func (c *UnityClientImpl) ListReplicationSessions(ctx context.Context) ([]types.ReplicationSession, error) {
+	sessionsResp := &types.ListReplicationSessions{}
+	err := c.executeWithRetryAuthenticate(ctx, http.MethodGet, fmt.Sprintf(api.UnityAPIInstanceTypeResourcesWithFields, api.ReplicationSessionAction, ReplicationSessionDisplayFields), nil, sessionsResp)
+	if err != nil {
+		return nil, fmt.Errorf("list replication sessions failed. Error: %v", err)
+	}
+	return sessionsResp.ReplicationSessions, nil
+}
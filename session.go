@@ -0,0 +1,206 @@
+/*
+ Copyright © 2025 Dell Inc. or its subsidiaries. All Rights Reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package gounity
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/dell/gounity/api"
+	types "github.com/dell/gounity/apitypes"
+	util "github.com/dell/gounity/gounityutil"
+)
+
+// Role constants of Unity users
+const (
+	RoleAdministrator = "administrator"
+	RoleStorageAdmin  = "storageadmin"
+	RoleSecurityAdmin = "securityadmin"
+	RoleVMAdmin       = "vmadmin"
+	RoleOperator      = "operator"
+)
+
+// DefaultSessionIdleTimeout is assumed when loginSessionInfo does not report the idle timeout of the session
+const DefaultSessionIdleTimeout = time.Hour
+
+// ErrorSessionNotFound stores error for login session not found
+var ErrorSessionNotFound = errors.New("unable to find login session")
+
+// SessionInfo holds the state of the login session as reported by loginSessionInfo
+type SessionInfo struct {
+	ID                       string
+	Domain                   string
+	UserID                   string
+	Username                 string
+	Roles                    []string
+	IdleTimeout              time.Duration
+	IsPasswordChangeRequired bool
+}
+
+// HasRole returns true if the session has any of the given roles
+func (s *SessionInfo) HasRole(roles ...string) bool {
+	for _, role := range roles {
+		if slices.Contains(s.Roles, role) {
+			return true
+		}
+	}
+	return false
+}
+
+// sessionState tracks the activity of the login session and its keep-alive loop
+type sessionState struct {
+	mutex         sync.Mutex
+	info          *SessionInfo
+	lastActivity  time.Time
+	stopKeepAlive context.CancelFunc
+}
+
+// touch records that a request succeeded with the current session
+func (s *sessionState) touch() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.lastActivity = time.Now()
+}
+
+// idleFor returns the time since the last successful request and the idle timeout of the session
+func (s *sessionState) idleFor() (time.Duration, time.Duration) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	idleTimeout := DefaultSessionIdleTimeout
+	if s.info != nil && s.info.IdleTimeout > 0 {
+		idleTimeout = s.info.IdleTimeout
+	}
+	return time.Since(s.lastActivity), idleTimeout
+}
+
+// reset forgets the session and stops its keep-alive loop
+func (s *sessionState) reset() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.info = nil
+	if s.stopKeepAlive != nil {
+		s.stopKeepAlive()
+		s.stopKeepAlive = nil
+	}
+}
+
+// GetSessionInfo returns the user, roles and idle timeout of the current login session.
+// As any request, it also resets the idle timer of the session.
+func (c *UnityClientImpl) GetSessionInfo(ctx context.Context) (*SessionInfo, error) {
+	log := util.GetRunIDLogger(ctx)
+	sessionResp := &types.ListLoginSessionInfo{}
+	err := c.executeWithRetryAuthenticate(ctx, http.MethodGet, fmt.Sprintf(api.UnityAPIInstanceTypeResourcesWithFields, api.LoginSessionInfoAction, LoginSessionInfoDisplayFields), nil, sessionResp)
+	if err != nil {
+		return nil, fmt.Errorf("get login session info error: %v", err)
+	}
+	if len(sessionResp.Entries) == 0 {
+		return nil, ErrorSessionNotFound
+	}
+
+	content := sessionResp.Entries[0].LoginSessionInfoContent
+	info := &SessionInfo{
+		ID:                       content.ID,
+		Domain:                   content.Domain,
+		UserID:                   content.User.ID,
+		Username:                 content.User.Name,
+		IdleTimeout:              time.Duration(content.IdleTimeout) * time.Second,
+		IsPasswordChangeRequired: content.IsPasswordChangeRequired,
+	}
+	for _, role := range content.Roles {
+		info.Roles = append(info.Roles, role.ID)
+	}
+	log.Debugf("Logged in as %s with roles %v, idle timeout: %v", info.Username, info.Roles, info.IdleTimeout)
+
+	c.session.mutex.Lock()
+	c.session.info = info
+	c.session.mutex.Unlock()
+	return info, nil
+}
+
+// StartSessionKeepAlive keeps the login session alive until ctx is cancelled or the client is logged out.
+// When no request was made for half of the session idle timeout, the session info is fetched to refresh it.
+// If the session already expired, the request logs in again.
+func (c *UnityClientImpl) StartSessionKeepAlive(ctx context.Context) {
+	log := util.GetRunIDLogger(ctx)
+	ctx, cancel := context.WithCancel(ctx)
+	c.session.mutex.Lock()
+	if c.session.stopKeepAlive != nil {
+		c.session.stopKeepAlive()
+	}
+	c.session.stopKeepAlive = cancel
+	c.session.mutex.Unlock()
+
+	if _, err := c.GetSessionInfo(ctx); err != nil {
+		log.Warnf("Unable to get the idle timeout of the session, assuming %v: %v", DefaultSessionIdleTimeout, err)
+	}
+	go func() {
+		for {
+			idle, idleTimeout := c.session.idleFor()
+			wait := idleTimeout/2 - idle
+			if wait <= 0 {
+				log.Debug("Refreshing the login session before idle expiry")
+				if _, err := c.GetSessionInfo(ctx); err != nil {
+					log.Warnf("Unable to refresh the login session: %v", err)
+				}
+				wait = idleTimeout / 2
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(wait):
+			}
+		}
+	}()
+}
+
+// Logout ends the login session using the loginSessionInfo logout action, then clears the token and the session cookies.
+// The keep-alive loop, if any, is stopped. Logging out without a session does nothing.
+func (c *UnityClientImpl) Logout(ctx context.Context) error {
+	c.loginMutex.Lock()
+	defer c.loginMutex.Unlock()
+	log := util.GetRunIDLogger(ctx)
+	c.session.reset()
+	if c.api.GetToken() == "" {
+		log.Debug("No login session to logout")
+		c.api.ClearCookies()
+		return nil
+	}
+
+	log.Debug("Executing Logout REST client")
+	headers := make(map[string]string, 1)
+	headers[api.XEmcRestClient] = "true"
+	err := c.api.DoWithHeaders(ctx, http.MethodPost, api.UnityAPILogoutURI, headers, types.LogoutParam{LocalCleanupOnly: true}, nil)
+	c.api.SetToken("")
+	c.api.ClearCookies()
+	if err != nil {
+		// The session already expired
+		if e, ok := err.(*types.Error); ok && e.ErrorContent.HTTPStatusCode == http.StatusUnauthorized {
+			return nil
+		}
+		return fmt.Errorf("logout error: %v", err)
+	}
+	log.Debug("Logout successful")
+	return nil
+}
+
+// Close logs out of the array, releasing the login session
+func (c *UnityClientImpl) Close() error {
+	return c.Logout(context.Background())
+}
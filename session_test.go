@@ -0,0 +1,117 @@
+/*
+ Copyright © 2025 Dell Inc. or its subsidiaries. All Rights Reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package gounity
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	types "github.com/dell/gounity/apitypes"
+	mocksapi "github.com/dell/gounity/mocks/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func mockSessionInfo(apiClient *mocksapi.Client, idleTimeout int) {
+	apiClient.On("DoWithHeaders", mock.Anything, "GET", "/api/types/loginSessionInfo/instances?fields="+LoginSessionInfoDisplayFields, mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(*types.ListLoginSessionInfo)
+		resp.Entries = []types.LoginSessionInfo{{LoginSessionInfoContent: types.LoginSessionInfoContent{
+			ID:          "session_1",
+			User:        types.SessionUser{ID: "user_admin", Name: "admin"},
+			Roles:       []types.Role{{ID: RoleStorageAdmin}},
+			IdleTimeout: idleTimeout,
+		}}}
+	})
+}
+
+func TestGetSessionInfo(t *testing.T) {
+	ctx := context.Background()
+	apiClient := &mocksapi.Client{}
+	client := &UnityClientImpl{api: apiClient, configConnect: &ConfigConnect{}}
+
+	mockSessionInfo(apiClient, 3600)
+	info, err := client.GetSessionInfo(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, "admin", info.Username)
+	assert.Equal(t, time.Hour, info.IdleTimeout)
+	assert.True(t, info.HasRole(RoleAdministrator, RoleStorageAdmin))
+	assert.False(t, info.HasRole(RoleAdministrator))
+
+	// Negative cases
+	apiClient.ExpectedCalls = nil
+	apiClient.On("DoWithHeaders", anyArgs...).Return(nil).Once()
+	_, err = client.GetSessionInfo(ctx)
+	assert.ErrorIs(t, err, ErrorSessionNotFound)
+
+	apiClient.On("DoWithHeaders", anyArgs...).Return(errors.New("error")).Once()
+	_, err = client.GetSessionInfo(ctx)
+	assert.Error(t, err)
+}
+
+func TestStartSessionKeepAlive(t *testing.T) {
+	ctx := context.Background()
+	apiClient := &mocksapi.Client{}
+	client := &UnityClientImpl{api: apiClient, configConnect: &ConfigConnect{}}
+
+	// An idle timeout of one second refreshes the session every half second
+	var refreshes atomic.Int32
+	apiClient.On("DoWithHeaders", anyArgs...).Return(nil).Run(func(args mock.Arguments) {
+		refreshes.Add(1)
+		resp := args.Get(5).(*types.ListLoginSessionInfo)
+		resp.Entries = []types.LoginSessionInfo{{LoginSessionInfoContent: types.LoginSessionInfoContent{IdleTimeout: 1}}}
+	})
+	apiClient.On("GetToken").Return("")
+	apiClient.On("ClearCookies").Return()
+	client.StartSessionKeepAlive(ctx)
+	assert.Eventually(t, func() bool {
+		return refreshes.Load() >= 3
+	}, 3*time.Second, 10*time.Millisecond)
+
+	// Logout stops the keep-alive loop
+	assert.NoError(t, client.Logout(ctx))
+	calls := refreshes.Load()
+	time.Sleep(700 * time.Millisecond)
+	assert.LessOrEqual(t, refreshes.Load(), calls+1)
+}
+
+func TestLogout(t *testing.T) {
+	ctx := context.Background()
+	apiClient := &mocksapi.Client{}
+	client := &UnityClientImpl{api: apiClient, configConnect: &ConfigConnect{}}
+
+	apiClient.On("GetToken").Return("token").Once()
+	apiClient.On("DoWithHeaders", mock.Anything, "POST", "/api/types/loginSessionInfo/action/logout", mock.Anything, types.LogoutParam{LocalCleanupOnly: true}, mock.Anything).Return(nil).Once()
+	apiClient.On("SetToken", "").Return()
+	apiClient.On("ClearCookies").Return()
+	assert.NoError(t, client.Logout(ctx))
+	apiClient.AssertCalled(t, "ClearCookies")
+
+	// Closing without a session does not call the array
+	apiClient.On("GetToken").Return("").Once()
+	assert.NoError(t, client.Close())
+
+	// An expired session is already logged out
+	apiClient.On("GetToken").Return("token")
+	apiClient.On("DoWithHeaders", anyArgs...).Return(&types.Error{ErrorContent: types.ErrorContent{HTTPStatusCode: 401}}).Once()
+	assert.NoError(t, client.Logout(ctx))
+
+	// Negative case
+	apiClient.On("DoWithHeaders", anyArgs...).Return(errors.New("error")).Once()
+	assert.Error(t, client.Logout(ctx))
+	apiClient.AssertNumberOfCalls(t, "DoWithHeaders", 3)
+}
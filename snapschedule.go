@@ -0,0 +1,90 @@
+/*
+ Copyright © 2025 Dell Inc. or its subsidiaries. All Rights Reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package gounity
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/dell/gounity/api"
+	types "github.com/dell/gounity/apitypes"
+)
+
+// SnapScheduleRuleType is the recurrence of a snapshot schedule rule
+type SnapScheduleRuleType int
+
+// SnapScheduleRuleType constants
+const (
+	// EveryNHours takes a snapshot every Interval hours at Minute
+	EveryNHours SnapScheduleRuleType = 0
+	// EveryDay takes a snapshot every day at Hours and Minute
+	EveryDay SnapScheduleRuleType = 1
+	// EveryNDays takes a snapshot every Interval days at Hours and Minute
+	EveryNDays SnapScheduleRuleType = 2
+	// SelectedDaysOfWeek takes a snapshot on DaysOfWeek at Hours and Minute, Sunday being 1
+	SelectedDaysOfWeek SnapScheduleRuleType = 3
+	// SelectedDaysOfMonth takes a snapshot on DaysOfMonth at Hours and Minute
+	SelectedDaysOfMonth SnapScheduleRuleType = 4
+)
+
+// ListSnapshotSchedules lists the snapshot schedules of the array with their rules
+// - Example: GET /api/types/snapSchedule/instances?fields=id,name,isDefault,rules.id,rules.type,...
+func (c *UnityClientImpl) ListSnapshotSchedules(ctx context.Context) ([]types.SnapSchedule, error) {
+	schedulesResp := &types.ListSnapSchedules{}
+	err := c.executeWithRetryAuthenticate(ctx, http.MethodGet, fmt.Sprintf(api.UnityAPIInstanceTypeResourcesWithFields, api.SnapScheduleAction, SnapScheduleDisplayFields), nil, schedulesResp)
+	if err != nil {
+		return nil, fmt.Errorf("list snapshot schedules failed. Error: %v", err)
+	}
+	return schedulesResp.SnapSchedules, nil
+}
+
+// CreateSnapshotSchedule creates a snapshot schedule with the given rules
+func (c *UnityClientImpl) CreateSnapshotSchedule(ctx context.Context, name string, rules []types.SnapScheduleRule) (_ *types.SnapSchedule, err error) {
+	ctx, span := c.startOperation(ctx, "CreateSnapshotSchedule", attributeResourceName.String(name))
+	defer func() { endOperation(span, err) }()
+	if name == "" {
+		return nil, errors.New("snapshot schedule name should not be empty")
+	}
+	if len(rules) == 0 {
+		return nil, errors.New("at least one rule is required to create a snapshot schedule")
+	}
+
+	scheduleReq := types.SnapScheduleCreateParam{
+		Name:  name,
+		Rules: rules,
+	}
+	scheduleResp := &types.SnapSchedule{}
+	err = c.executeWithRetryAuthenticate(ctx, http.MethodPost, fmt.Sprintf(api.UnityAPIInstanceTypeResources, api.SnapScheduleAction), scheduleReq, scheduleResp)
+	if err != nil {
+		return nil, fmt.Errorf("create snapshot schedule %s failed. Error: %v", name, err)
+	}
+	return scheduleResp, nil
+}
+
+// DeleteSnapshotSchedule deletes the snapshot schedule. The schedule must not be assigned to any storage resource.
+func (c *UnityClientImpl) DeleteSnapshotSchedule(ctx context.Context, scheduleID string) (err error) {
+	ctx, span := c.startOperation(ctx, "DeleteSnapshotSchedule", attributeResourceID.String(scheduleID))
+	defer func() { endOperation(span, err) }()
+	if scheduleID == "" {
+		return errors.New("snapshot schedule Id cannot be empty")
+	}
+	err = c.executeWithRetryAuthenticate(ctx, http.MethodDelete, fmt.Sprintf(api.UnityAPIGetResourceURI, api.SnapScheduleAction, scheduleID), nil, nil)
+	if err != nil {
+		return fmt.Errorf("delete snapshot schedule %s failed. Error: %v", scheduleID, err)
+	}
+	return nil
+}
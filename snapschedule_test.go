@@ -0,0 +1,65 @@
+/*
+ Copyright © 2025 Dell Inc. or its subsidiaries. All Rights Reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package gounity
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	types "github.com/dell/gounity/apitypes"
+	mocksapi "github.com/dell/gounity/mocks/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSnapshotSchedules(t *testing.T) {
+	ctx := context.Background()
+	apiClient := &mocksapi.Client{}
+	client := &UnityClientImpl{api: apiClient, configConnect: &ConfigConnect{}}
+
+	apiClient.On("DoWithHeaders", mock.Anything, "GET", "/api/types/snapSchedule/instances?fields="+SnapScheduleDisplayFields, mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(*types.ListSnapSchedules)
+		resp.SnapSchedules = []types.SnapSchedule{{SnapScheduleContent: types.SnapScheduleContent{ID: "snapSch_1", Name: "daily"}}}
+	}).Once()
+	schedules, err := client.ListSnapshotSchedules(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, schedules, 1)
+
+	rules := []types.SnapScheduleRule{{Type: int(EveryDay), Hours: []int{2}, RetentionTime: 86400}}
+	apiClient.On("DoWithHeaders", mock.Anything, "POST", "/api/types/snapSchedule/instances", mock.Anything, types.SnapScheduleCreateParam{Name: "daily", Rules: rules}, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		args.Get(5).(*types.SnapSchedule).SnapScheduleContent.ID = "snapSch_2"
+	}).Once()
+	schedule, err := client.CreateSnapshotSchedule(ctx, "daily", rules)
+	assert.NoError(t, err)
+	assert.Equal(t, "snapSch_2", schedule.SnapScheduleContent.ID)
+
+	apiClient.On("DoWithHeaders", mock.Anything, "DELETE", "/api/instances/snapSchedule/snapSch_2", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+	assert.NoError(t, client.DeleteSnapshotSchedule(ctx, "snapSch_2"))
+
+	// Negative cases
+	_, err = client.CreateSnapshotSchedule(ctx, "", rules)
+	assert.Error(t, err)
+	_, err = client.CreateSnapshotSchedule(ctx, "daily", nil)
+	assert.Error(t, err)
+	assert.Error(t, client.DeleteSnapshotSchedule(ctx, ""))
+
+	apiClient.On("DoWithHeaders", anyArgs...).Return(errors.New("error"))
+	_, err = client.ListSnapshotSchedules(ctx)
+	assert.Error(t, err)
+	_, err = client.CreateSnapshotSchedule(ctx, "daily", rules)
+	assert.Error(t, err)
+	assert.Error(t, client.DeleteSnapshotSchedule(ctx, "snapSch_2"))
+}
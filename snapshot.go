@@ -57,12 +57,14 @@ func (c *UnityClientImpl) CreateSnapshot(ctx context.Context, storageResourceID,
 }
 
 // CreateSnapshotWithFsAccesType - Creates snashot with FsAccess type
-func (c *UnityClientImpl) CreateSnapshotWithFsAccesType(ctx context.Context, storageResourceID, snapshotName, _, retentionDuration string, filesystemAccessType FilesystemAccessType) (*types.Snapshot, error) {
+func (c *UnityClientImpl) CreateSnapshotWithFsAccesType(ctx context.Context, storageResourceID, snapshotName, _, retentionDuration string, filesystemAccessType FilesystemAccessType) (_ *types.Snapshot, err error) {
+	ctx, span := c.startOperation(ctx, "CreateSnapshotWithFsAccesType", attributeResourceName.String(snapshotName))
+	defer func() { endOperation(span, err) }()
+	defer c.resourceCache.invalidate(cachePools)
 	var createSnapshot types.CreateSnapshotParam
 	if len(storageResourceID) == 0 {
 		return nil, errors.New("storage Resource ID cannot be empty")
 	}
-	var err error
 	createSnapshot.Name, err = util.ValidateResourceName(snapshotName, api.MaxResourceNameLength)
 	if err != nil {
 		return nil, fmt.Errorf("invalid snapshot name Error:%v", err)
@@ -93,13 +95,16 @@ func (c *UnityClientImpl) CreateSnapshotWithFsAccesType(ctx context.Context, sto
 }
 
 // DeleteFilesystemAsSnapshot - Delete Snapshots acting as filesystem on array
-func (c *UnityClientImpl) DeleteFilesystemAsSnapshot(ctx context.Context, snapshotID string, sourceFs *types.Filesystem) error {
+func (c *UnityClientImpl) DeleteFilesystemAsSnapshot(ctx context.Context, snapshotID string, sourceFs *types.Filesystem) (err error) {
+	ctx, span := c.startOperation(ctx, "DeleteFilesystemAsSnapshot", attributeResourceID.String(snapshotID))
+	defer func() { endOperation(span, err) }()
+	defer c.resourceCache.invalidate(cachePools)
 	log := util.GetRunIDLogger(ctx)
 	deleteSourceFs := false
 	if strings.Contains(sourceFs.FileContent.Description, MarkFilesystemForDeletion) {
 		deleteSourceFs = true
 	}
-	err := c.DeleteSnapshot(ctx, snapshotID)
+	err = c.DeleteSnapshot(ctx, snapshotID)
 	if err != nil {
 		return err
 	}
@@ -121,7 +126,10 @@ func (c *UnityClientImpl) DeleteFilesystemAsSnapshot(ctx context.Context, snapsh
 //
 // Returns:
 // - an error if delete snapshot fails
-func (c *UnityClientImpl) DeleteSnapshot(ctx context.Context, snapshotID string) error {
+func (c *UnityClientImpl) DeleteSnapshot(ctx context.Context, snapshotID string) (err error) {
+	ctx, span := c.startOperation(ctx, "DeleteSnapshot", attributeResourceID.String(snapshotID))
+	defer func() { endOperation(span, err) }()
+	defer c.resourceCache.invalidate(cachePools)
 	log := util.GetRunIDLogger(ctx)
 	if snapshotID == "" {
 		return errors.New("snapshot ID cannot be empty")
@@ -218,7 +226,9 @@ func (c *UnityClientImpl) FindSnapshotByID(ctx context.Context, snapshotID strin
 }
 
 // ModifySnapshotAutoDeleteParameter - Modify Snapshot (currently used to disable auto-delete parameter)
-func (c *UnityClientImpl) ModifySnapshotAutoDeleteParameter(ctx context.Context, snapshotID string) error {
+func (c *UnityClientImpl) ModifySnapshotAutoDeleteParameter(ctx context.Context, snapshotID string) (err error) {
+	ctx, span := c.startOperation(ctx, "ModifySnapshotAutoDeleteParameter", attributeResourceID.String(snapshotID))
+	defer func() { endOperation(span, err) }()
 	log := util.GetRunIDLogger(ctx)
 	if snapshotID == "" {
 		return errors.New("snapshot ID cannot be empty")
@@ -229,7 +239,7 @@ func (c *UnityClientImpl) ModifySnapshotAutoDeleteParameter(ctx context.Context,
 	}
 	snapshotResp := &types.Snapshot{}
 
-	err := c.executeWithRetryAuthenticate(ctx, http.MethodPost, fmt.Sprintf(api.UnityModifySnapshotURI, api.SnapAction, snapshotID), modifySnapshot, snapshotResp)
+	err = c.executeWithRetryAuthenticate(ctx, http.MethodPost, fmt.Sprintf(api.UnityModifySnapshotURI, api.SnapAction, snapshotID), modifySnapshot, snapshotResp)
 	if err != nil {
 		return fmt.Errorf("unable to modify Snapshot %s Error: %v", snapshotID, err)
 	}
@@ -238,7 +248,10 @@ func (c *UnityClientImpl) ModifySnapshotAutoDeleteParameter(ctx context.Context,
 }
 
 // CopySnapshot - Creates a copy of the source snapshot which can be used for NFS export, and returns the ID of the copy snapshot
-func (c *UnityClientImpl) CopySnapshot(ctx context.Context, sourceSnapshotID, name string) (*types.Snapshot, error) {
+func (c *UnityClientImpl) CopySnapshot(ctx context.Context, sourceSnapshotID, name string) (_ *types.Snapshot, err error) {
+	ctx, span := c.startOperation(ctx, "CopySnapshot", attributeResourceName.String(name))
+	defer func() { endOperation(span, err) }()
+	defer c.resourceCache.invalidate(cachePools)
 	if name == "" {
 		return nil, errors.New("Snapshot Name cannot be empty")
 	}
@@ -253,7 +266,7 @@ func (c *UnityClientImpl) CopySnapshot(ctx context.Context, sourceSnapshotID, na
 	}
 
 	snapsResp := &types.CopySnapshots{}
-	err := c.executeWithRetryAuthenticate(ctx, http.MethodPost, fmt.Sprintf(api.UnityCopySnapshotURI, api.SnapAction, sourceSnapshotID), copySnapshotReq, snapsResp)
+	err = c.executeWithRetryAuthenticate(ctx, http.MethodPost, fmt.Sprintf(api.UnityCopySnapshotURI, api.SnapAction, sourceSnapshotID), copySnapshotReq, snapsResp)
 	if err != nil {
 		return nil, fmt.Errorf("unable to Copy Snapshot %s. Error: %v", sourceSnapshotID, err)
 	}
@@ -267,7 +280,9 @@ func (c *UnityClientImpl) CopySnapshot(ctx context.Context, sourceSnapshotID, na
 }
 
 // ModifySnapshot - Modify Snapshot's description and retention duration parameters
-func (c *UnityClientImpl) ModifySnapshot(ctx context.Context, snapshotID, description, retentionDuration string) error {
+func (c *UnityClientImpl) ModifySnapshot(ctx context.Context, snapshotID, description, retentionDuration string) (err error) {
+	ctx, span := c.startOperation(ctx, "ModifySnapshot", attributeResourceID.String(snapshotID))
+	defer func() { endOperation(span, err) }()
 	if snapshotID == "" {
 		return errors.New("snapshot ID cannot be empty")
 	}
@@ -287,7 +302,7 @@ func (c *UnityClientImpl) ModifySnapshot(ctx context.Context, snapshotID, descri
 	}
 	snapshotResp := &types.Snapshot{}
 
-	err := c.executeWithRetryAuthenticate(ctx, http.MethodPost, fmt.Sprintf(api.UnityModifySnapshotURI, api.SnapAction, snapshotID), modifySnapshot, snapshotResp)
+	err = c.executeWithRetryAuthenticate(ctx, http.MethodPost, fmt.Sprintf(api.UnityModifySnapshotURI, api.SnapAction, snapshotID), modifySnapshot, snapshotResp)
 	if err != nil {
 		return fmt.Errorf("unable to modify Snapshot %s Error: %v", snapshotID, err)
 	}
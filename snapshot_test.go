@@ -48,10 +48,13 @@ func TestCreateSnapshot(t *testing.T) {
 	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).ExpectedCalls = nil
 	ctx := context.Background()
 	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", anyArgs...).Return(nil).Once()
-	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.AnythingOfType("*apitypes.LicenseInfo")).Return(nil).
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.AnythingOfType("*apitypes.ListLicenses")).Return(nil).
 		Run(func(args mock.Arguments) {
-			resp := args.Get(5).(*types.LicenseInfo)
-			*resp = types.LicenseInfo{LicenseInfoContent: types.LicenseInfoContent{IsInstalled: true, IsValid: true}}
+			resp := args.Get(5).(*types.ListLicenses)
+			resp.Licenses = []types.License{
+				{LicenseContent: types.LicenseContent{ID: string(ThinProvisioning), IsInstalled: true, IsValid: true}},
+				{LicenseContent: types.LicenseContent{ID: string(DataReduction), IsInstalled: true, IsValid: true}},
+			}
 		}).Twice()
 
 	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", anyArgs...).Return(nil).Once()
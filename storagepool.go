@@ -26,15 +26,48 @@ import (
 
 	"github.com/dell/gounity/api"
 	types "github.com/dell/gounity/apitypes"
+	util "github.com/dell/gounity/gounityutil"
 )
 
+// RaidType is integer
+type RaidType int
+
+// RaidType constants
+const (
+	RaidTypeNone      RaidType = 0
+	RaidType5         RaidType = 1
+	RaidType0         RaidType = 2
+	RaidType1         RaidType = 3
+	RaidType3         RaidType = 4
+	RaidType10        RaidType = 7
+	RaidType6         RaidType = 10
+	RaidTypeAutomatic RaidType = 48879
+)
+
+// Bounds of the alert threshold of a storage pool, in percent of its used capacity
+const (
+	MinPoolAlertThreshold = 50
+	MaxPoolAlertThreshold = 84
+)
+
+// NewRaidGroupParameters returns the parameters to add numDisks disks of the given disk group to a pool.
+// A stripeWidth of 0 lets the array choose the stripe width.
+func NewRaidGroupParameters(diskGroupID string, numDisks int, raidType RaidType, stripeWidth int) types.RaidGroupParameters {
+	return types.RaidGroupParameters{
+		DiskGroup:   &types.DiskGroupID{ID: diskGroupID},
+		NumDisks:    numDisks,
+		RaidType:    int(raidType),
+		StripeWidth: stripeWidth,
+	}
+}
+
 // FindStoragePoolByName - Find the volume by it's name. If the volume is not found, an error will be returned.
 func (c *UnityClientImpl) FindStoragePoolByName(ctx context.Context, poolName string) (*types.StoragePool, error) {
 	if len(poolName) == 0 {
 		return nil, errors.New("poolName shouldn't be empty")
 	}
 	spResponse := &types.StoragePool{}
-	err := c.executeWithRetryAuthenticate(ctx, http.MethodGet, fmt.Sprintf(api.UnityAPIGetResourceByNameWithFieldsURI, api.PoolAction, poolName, StoragePoolFields), nil, spResponse)
+	err := c.getCached(ctx, cachePools, fmt.Sprintf(api.UnityAPIGetResourceByNameWithFieldsURI, api.PoolAction, poolName, StoragePoolFields), spResponse)
 	if err != nil {
 		return nil, fmt.Errorf("find storage pool by name failed %s err: %v", poolName, err)
 	}
@@ -49,10 +82,122 @@ func (c *UnityClientImpl) FindStoragePoolByID(ctx context.Context, poolID string
 	}
 	spResponse := &types.StoragePool{}
 
-	err := c.executeWithRetryAuthenticate(ctx, http.MethodGet, fmt.Sprintf(api.UnityAPIGetResourceWithFieldsURI, api.PoolAction, poolID, StoragePoolFields), nil, spResponse)
+	err := c.getCached(ctx, cachePools, fmt.Sprintf(api.UnityAPIGetResourceWithFieldsURI, api.PoolAction, poolID, StoragePoolFields), spResponse)
 	if err != nil {
 		return nil, fmt.Errorf("find storage pool by ID failed %s err: %v", poolID, err)
 	}
 
 	return spResponse, nil
 }
+
+// ListStoragePools lists all the storage pools of the array.
+// - Example: GET /api/types/pool/instances?fields=id,name,description,sizeFree,...
+func (c *UnityClientImpl) ListStoragePools(ctx context.Context) ([]types.StoragePool, error) {
+	poolsResp := &types.ListStoragePools{}
+	err := c.getCached(ctx, cachePools, fmt.Sprintf(api.UnityAPIInstanceTypeResourcesWithFields, api.PoolAction, StoragePoolFields), poolsResp)
+	if err != nil {
+		return nil, fmt.Errorf("list storage pools failed err: %v", err)
+	}
+	return poolsResp.StoragePools, nil
+}
+
+// CreateStoragePool - Create a storage pool from the disks of the given disk groups.
+func (c *UnityClientImpl) CreateStoragePool(ctx context.Context, name, description string, raidGroups []types.RaidGroupParameters) (_ *types.StoragePool, err error) {
+	ctx, span := c.startOperation(ctx, "CreateStoragePool", attributeResourceName.String(name))
+	defer func() { endOperation(span, err) }()
+	log := util.GetRunIDLogger(ctx)
+	if name == "" {
+		return nil, errors.New("pool name should not be empty")
+	}
+	if len(name) > api.MaxResourceNameLength {
+		return nil, fmt.Errorf("pool name %s should not exceed %d characters", name, api.MaxResourceNameLength)
+	}
+	if len(raidGroups) == 0 {
+		return nil, errors.New("at least one disk group is required to create a pool")
+	}
+
+	poolReq := types.PoolCreateParam{
+		Name:                   name,
+		Description:            description,
+		AddRaidGroupParameters: raidGroups,
+	}
+	poolResp := &types.StoragePool{}
+	defer c.resourceCache.invalidate(cachePools)
+	err = c.executeWithRetryAuthenticate(ctx, http.MethodPost, fmt.Sprintf(api.UnityAPIInstanceTypeResources, api.PoolAction), poolReq, poolResp)
+	if err != nil {
+		return nil, fmt.Errorf("create storage pool %s failed. Error: %v", name, err)
+	}
+	log.Debugf("Storage pool %s created with ID: %s", name, poolResp.StoragePoolContent.ID)
+	return poolResp, nil
+}
+
+// ExpandStoragePool - Expand the storage pool with the disks of the given disk groups.
+func (c *UnityClientImpl) ExpandStoragePool(ctx context.Context, poolID string, raidGroups []types.RaidGroupParameters) error {
+	if len(raidGroups) == 0 {
+		return errors.New("at least one disk group is required to expand a pool")
+	}
+	return c.ModifyStoragePool(ctx, poolID, types.PoolModifyParam{AddRaidGroupParameters: raidGroups})
+}
+
+// ModifyStoragePool - Modify the name, description, alert threshold, FAST Cache, FAST VP schedule and
+// snapshot space harvesting settings of the storage pool. Fields left empty in the params are not modified.
+func (c *UnityClientImpl) ModifyStoragePool(ctx context.Context, poolID string, params types.PoolModifyParam) (err error) {
+	ctx, span := c.startOperation(ctx, "ModifyStoragePool", attributeResourceID.String(poolID))
+	defer func() { endOperation(span, err) }()
+	if poolID == "" {
+		return errors.New("pool Id cannot be empty")
+	}
+	if len(params.Name) > api.MaxResourceNameLength {
+		return fmt.Errorf("pool name %s should not exceed %d characters", params.Name, api.MaxResourceNameLength)
+	}
+	if params.AlertThreshold != 0 && (params.AlertThreshold < MinPoolAlertThreshold || params.AlertThreshold > MaxPoolAlertThreshold) {
+		return fmt.Errorf("invalid alert threshold %d, it should be between %d and %d", params.AlertThreshold, MinPoolAlertThreshold, MaxPoolAlertThreshold)
+	}
+	if params.SnapSpaceHarvestLowThreshold > 0 && params.SnapSpaceHarvestHighThreshold > 0 &&
+		params.SnapSpaceHarvestLowThreshold >= params.SnapSpaceHarvestHighThreshold {
+		return fmt.Errorf("snapshot space harvest low threshold %v should be less than high threshold %v", params.SnapSpaceHarvestLowThreshold, params.SnapSpaceHarvestHighThreshold)
+	}
+
+	defer c.resourceCache.invalidate(cachePools)
+	err = c.executeWithRetryAuthenticate(ctx, http.MethodPost, fmt.Sprintf(api.UnityModifyPoolURI, api.PoolAction, poolID), params, nil)
+	if err != nil {
+		return fmt.Errorf("modify storage pool %s failed. Error: %v", poolID, err)
+	}
+	return nil
+}
+
+// DeleteStoragePool - Delete the storage pool. The pool must not contain any storage resources.
+func (c *UnityClientImpl) DeleteStoragePool(ctx context.Context, poolID string) (err error) {
+	ctx, span := c.startOperation(ctx, "DeleteStoragePool", attributeResourceID.String(poolID))
+	defer func() { endOperation(span, err) }()
+	if poolID == "" {
+		return errors.New("pool Id cannot be empty")
+	}
+	defer c.resourceCache.invalidate(cachePools)
+	err = c.executeWithRetryAuthenticate(ctx, http.MethodDelete, fmt.Sprintf(api.UnityAPIGetResourceURI, api.PoolAction, poolID), nil, nil)
+	if err != nil {
+		return fmt.Errorf("delete storage pool %s failed. Error: %v", poolID, err)
+	}
+	return nil
+}
+
+// ListPoolUnits lists the pool units, the RAID groups and virtual disks providing capacity to the pools.
+func (c *UnityClientImpl) ListPoolUnits(ctx context.Context) ([]types.PoolUnit, error) {
+	poolUnitsResp := &types.ListPoolUnits{}
+	err := c.executeWithRetryAuthenticate(ctx, http.MethodGet, fmt.Sprintf(api.UnityAPIInstanceTypeResourcesWithFields, api.PoolUnitAction, PoolUnitDisplayFields), nil, poolUnitsResp)
+	if err != nil {
+		return nil, fmt.Errorf("list pool units failed err: %v", err)
+	}
+	return poolUnitsResp.PoolUnits, nil
+}
+
+// ListDiskGroups lists the disk groups of the array. The unconfigured disks of a disk group
+// are the disks which can still be added to a pool.
+func (c *UnityClientImpl) ListDiskGroups(ctx context.Context) ([]types.DiskGroup, error) {
+	diskGroupsResp := &types.ListDiskGroups{}
+	err := c.executeWithRetryAuthenticate(ctx, http.MethodGet, fmt.Sprintf(api.UnityAPIInstanceTypeResourcesWithFields, api.DiskGroupAction, DiskGroupDisplayFields), nil, diskGroupsResp)
+	if err != nil {
+		return nil, fmt.Errorf("list disk groups failed err: %v", err)
+	}
+	return diskGroupsResp.DiskGroups, nil
+}
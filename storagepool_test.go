@@ -16,11 +16,14 @@ package gounity
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"testing"
 
+	types "github.com/dell/gounity/apitypes"
 	mocksapi "github.com/dell/gounity/mocks/api"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
@@ -97,3 +100,156 @@ func TestFindStoragePoolByNameTest(t *testing.T) {
 
 	fmt.Println("Find Storage Pool by Name Test - Successful")
 }
+
+func TestListStoragePools(t *testing.T) {
+	fmt.Println("Begin - List Storage Pools Test")
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).ExpectedCalls = nil
+	ctx := context.Background()
+
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", mock.Anything, "GET", "/api/types/pool/instances?fields="+StoragePoolFields, mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(*types.ListStoragePools)
+		resp.StoragePools = []types.StoragePool{{StoragePoolContent: types.StoragePoolContent{ID: "pool_1"}}, {StoragePoolContent: types.StoragePoolContent{ID: "pool_2"}}}
+	}).Once()
+	pools, err := testConf.client.ListStoragePools(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, pools, 2)
+
+	// Negative case
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", anyArgs...).Return(errors.New("list pools failed")).Once()
+	_, err = testConf.client.ListStoragePools(ctx)
+	assert.Error(t, err)
+
+	fmt.Println("List Storage Pools Test - Successful")
+}
+
+func TestCreateStoragePool(t *testing.T) {
+	fmt.Println("Begin - Create Storage Pool Test")
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).ExpectedCalls = nil
+	ctx := context.Background()
+	raidGroups := []types.RaidGroupParameters{NewRaidGroupParameters("dg_1", 5, RaidType5, 5)}
+
+	poolReq := types.PoolCreateParam{Name: "pool-1", Description: "Pool", AddRaidGroupParameters: raidGroups}
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", mock.Anything, "POST", "/api/types/pool/instances", mock.Anything, poolReq, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(*types.StoragePool)
+		resp.StoragePoolContent.ID = "pool_5"
+	}).Once()
+	pool, err := testConf.client.CreateStoragePool(ctx, "pool-1", "Pool", raidGroups)
+	assert.NoError(t, err)
+	assert.Equal(t, "pool_5", pool.StoragePoolContent.ID)
+
+	// Negative cases
+	_, err = testConf.client.CreateStoragePool(ctx, "", "Pool", raidGroups)
+	assert.Error(t, err)
+
+	_, err = testConf.client.CreateStoragePool(ctx, "pool-1", "Pool", nil)
+	assert.Error(t, err)
+
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", anyArgs...).Return(errors.New("not enough disks")).Once()
+	_, err = testConf.client.CreateStoragePool(ctx, "pool-1", "Pool", raidGroups)
+	assert.Error(t, err)
+
+	fmt.Println("Create Storage Pool Test - Successful")
+}
+
+func TestModifyStoragePool(t *testing.T) {
+	fmt.Println("Begin - Modify Storage Pool Test")
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).ExpectedCalls = nil
+	ctx := context.Background()
+
+	enabled, disabled := true, false
+	params := types.PoolModifyParam{
+		Name:                          "pool-2",
+		AlertThreshold:                80,
+		IsFASTCacheEnabled:            &enabled,
+		IsFASTVpScheduleEnabled:       &disabled,
+		IsSnapHarvestEnabled:          &enabled,
+		SnapSpaceHarvestHighThreshold: 25,
+		SnapSpaceHarvestLowThreshold:  20,
+	}
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", mock.Anything, "POST", "/api/instances/pool/pool_1/action/modify", mock.Anything, params, mock.Anything).Return(nil).Once()
+	err := testConf.client.ModifyStoragePool(ctx, "pool_1", params)
+	assert.NoError(t, err)
+
+	// false is sent, unset settings are not
+	data, err := json.Marshal(types.PoolModifyParam{IsFASTVpScheduleEnabled: &disabled})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"isFASTVpScheduleEnabled": false}`, string(data))
+
+	raidGroups := []types.RaidGroupParameters{NewRaidGroupParameters("dg_2", 6, RaidType6, 0)}
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", mock.Anything, "POST", "/api/instances/pool/pool_1/action/modify", mock.Anything, types.PoolModifyParam{AddRaidGroupParameters: raidGroups}, mock.Anything).Return(nil).Once()
+	err = testConf.client.ExpandStoragePool(ctx, "pool_1", raidGroups)
+	assert.NoError(t, err)
+
+	// Negative cases
+	err = testConf.client.ModifyStoragePool(ctx, "", params)
+	assert.Error(t, err)
+
+	err = testConf.client.ModifyStoragePool(ctx, "pool_1", types.PoolModifyParam{AlertThreshold: 85})
+	assert.Error(t, err)
+
+	err = testConf.client.ModifyStoragePool(ctx, "pool_1", types.PoolModifyParam{AlertThreshold: 49})
+	assert.Error(t, err)
+
+	err = testConf.client.ModifyStoragePool(ctx, "pool_1", types.PoolModifyParam{SnapSpaceHarvestHighThreshold: 20, SnapSpaceHarvestLowThreshold: 25})
+	assert.Error(t, err)
+
+	err = testConf.client.ExpandStoragePool(ctx, "pool_1", nil)
+	assert.Error(t, err)
+
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", anyArgs...).Return(errors.New("modify failed")).Once()
+	err = testConf.client.ModifyStoragePool(ctx, "pool_1", params)
+	assert.Error(t, err)
+
+	fmt.Println("Modify Storage Pool Test - Successful")
+}
+
+func TestDeleteStoragePool(t *testing.T) {
+	fmt.Println("Begin - Delete Storage Pool Test")
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).ExpectedCalls = nil
+	ctx := context.Background()
+
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", mock.Anything, "DELETE", "/api/instances/pool/pool_1", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+	err := testConf.client.DeleteStoragePool(ctx, "pool_1")
+	assert.NoError(t, err)
+
+	// Negative cases
+	err = testConf.client.DeleteStoragePool(ctx, "")
+	assert.Error(t, err)
+
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", anyArgs...).Return(errors.New("pool in use")).Once()
+	err = testConf.client.DeleteStoragePool(ctx, "pool_1")
+	assert.Error(t, err)
+
+	fmt.Println("Delete Storage Pool Test - Successful")
+}
+
+func TestListPoolUnitsAndDiskGroups(t *testing.T) {
+	fmt.Println("Begin - List Pool Units and Disk Groups Test")
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).ExpectedCalls = nil
+	ctx := context.Background()
+
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", mock.Anything, "GET", "/api/types/poolUnit/instances?fields="+PoolUnitDisplayFields, mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(*types.ListPoolUnits)
+		resp.PoolUnits = []types.PoolUnit{{PoolUnitContent: types.PoolUnitContent{ID: "rg_1", Pool: types.Pool{ID: "pool_1"}}}}
+	}).Once()
+	poolUnits, err := testConf.client.ListPoolUnits(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, poolUnits, 1)
+
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", mock.Anything, "GET", "/api/types/diskGroup/instances?fields="+DiskGroupDisplayFields, mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(*types.ListDiskGroups)
+		resp.DiskGroups = []types.DiskGroup{{DiskGroupContent: types.DiskGroupContent{ID: "dg_1", TotalDisks: 10, UnconfiguredDisks: 5}}}
+	}).Once()
+	diskGroups, err := testConf.client.ListDiskGroups(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, 5, diskGroups[0].DiskGroupContent.UnconfiguredDisks)
+
+	// Negative cases
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", anyArgs...).Return(errors.New("list failed")).Twice()
+	_, err = testConf.client.ListPoolUnits(ctx)
+	assert.Error(t, err)
+	_, err = testConf.client.ListDiskGroups(ctx)
+	assert.Error(t, err)
+
+	fmt.Println("List Pool Units and Disk Groups Test - Successful")
+}
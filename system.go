@@ -0,0 +1,238 @@
+/*
+ Copyright © 2025 Dell Inc. or its subsidiaries. All Rights Reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package gounity
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/dell/gounity/api"
+	types "github.com/dell/gounity/apitypes"
+	util "github.com/dell/gounity/gounityutil"
+)
+
+// Health values reported in HealthContent
+const (
+	HealthUnknown        = 0
+	HealthOK             = 5
+	HealthOKBut          = 7
+	HealthDegraded       = 10
+	HealthMinor          = 15
+	HealthMajor          = 20
+	HealthCritical       = 25
+	HealthNonRecoverable = 30
+)
+
+// ErrorSystemNotFound stores error for system instance not found
+var ErrorSystemNotFound = errors.New("unable to find system instance")
+
+// SystemInfo holds the details of the system instance and the versions reported by basicSystemInfo
+type SystemInfo struct {
+	ID                 string
+	Name               string
+	Model              string
+	SerialNumber       string
+	Platform           string
+	Health             types.HealthContent
+	SoftwareVersion    string
+	APIVersion         string
+	EarliestAPIVersion string
+}
+
+// HardwareInventory holds the hardware components of the array
+type HardwareInventory struct {
+	StorageProcessors []types.StorageProcessor
+	DPEs              []types.Enclosure
+	DAEs              []types.Enclosure
+	Disks             []types.Disk
+	PowerSupplies     []types.HardwareComponent
+	Batteries         []types.HardwareComponent
+	Fans              []types.HardwareComponent
+}
+
+// ComponentHealth holds the health of a single component
+type ComponentHealth struct {
+	Type   string
+	ID     string
+	Name   string
+	Health types.HealthContent
+}
+
+// SystemHealthReport lists the system health and every component whose health is not OK
+type SystemHealthReport struct {
+	System              ComponentHealth
+	UnhealthyComponents []ComponentHealth
+}
+
+// IsHealthy returns true if the system and all of its components are OK
+func (r *SystemHealthReport) IsHealthy() bool {
+	return r.System.Health.Value == HealthOK && len(r.UnhealthyComponents) == 0
+}
+
+// GetBasicSystemInfo gets the basicSystemInfo instance with the model, name and versions of the array
+// and refreshes the capability set of the client.
+// - Example: GET /api/types/basicSystemInfo/instances?fields=id,model,name,softwareVersion,apiVersion,earliestApiVersion
+func (c *UnityClientImpl) GetBasicSystemInfo(ctx context.Context) (*types.Content, error) {
+	basicSystemInfo := &types.BasicSystemInfo{}
+	err := c.executeWithRetryAuthenticate(ctx, http.MethodGet, fmt.Sprintf(api.UnityAPIInstanceTypeResourcesWithFields, api.BasicSystemInfoAction, BasicSystemInfoFields), nil, basicSystemInfo)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get basic system info: %v", err)
+	}
+	if len(basicSystemInfo.Entries) == 0 {
+		return nil, ErrorSystemNotFound
+	}
+	c.setCapabilities(ctx, &basicSystemInfo.Entries[0].Content)
+	return &basicSystemInfo.Entries[0].Content, nil
+}
+
+// GetSystemInfo gets the model, serial number, health and software and API versions of the array.
+func (c *UnityClientImpl) GetSystemInfo(ctx context.Context) (*SystemInfo, error) {
+	systemsResp := &types.ListSystems{}
+	err := c.executeWithRetryAuthenticate(ctx, http.MethodGet, fmt.Sprintf(api.UnityAPIInstanceTypeResourcesWithFields, api.SystemAction, SystemDisplayFields), nil, systemsResp)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get system: %v", err)
+	}
+	if len(systemsResp.Systems) == 0 {
+		return nil, ErrorSystemNotFound
+	}
+	system := systemsResp.Systems[0].SystemContent
+
+	basicSystemInfo, err := c.GetBasicSystemInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SystemInfo{
+		ID:                 system.ID,
+		Name:               system.Name,
+		Model:              system.Model,
+		SerialNumber:       system.SerialNumber,
+		Platform:           system.Platform,
+		Health:             system.Health,
+		SoftwareVersion:    basicSystemInfo.SoftwareVersion,
+		APIVersion:         basicSystemInfo.APIVersion,
+		EarliestAPIVersion: basicSystemInfo.EarliestAPIVersion,
+	}, nil
+}
+
+// listHardware lists all instances of the given hardware resource type into resp
+func (c *UnityClientImpl) listHardware(ctx context.Context, resource, fields string, resp interface{}) error {
+	err := c.executeWithRetryAuthenticate(ctx, http.MethodGet, fmt.Sprintf(api.UnityAPIInstanceTypeResourcesWithFields, resource, fields), nil, resp)
+	if err != nil {
+		return fmt.Errorf("unable to list %s: %v", resource, err)
+	}
+	return nil
+}
+
+// GetHardwareInventory lists the storage processors, DPE and DAE enclosures, disks, power supplies,
+// batteries and fans of the array.
+func (c *UnityClientImpl) GetHardwareInventory(ctx context.Context) (*HardwareInventory, error) {
+	log := util.GetRunIDLogger(ctx)
+	inventory := &HardwareInventory{}
+
+	spResp := &types.ListStorageProcessors{}
+	if err := c.listHardware(ctx, api.StorageProcessorAction, StorageProcessorDisplayFields, spResp); err != nil {
+		return nil, err
+	}
+	inventory.StorageProcessors = spResp.StorageProcessors
+
+	dpeResp := &types.ListEnclosures{}
+	if err := c.listHardware(ctx, api.DpeAction, EnclosureDisplayFields, dpeResp); err != nil {
+		return nil, err
+	}
+	inventory.DPEs = dpeResp.Enclosures
+
+	daeResp := &types.ListEnclosures{}
+	if err := c.listHardware(ctx, api.DaeAction, EnclosureDisplayFields, daeResp); err != nil {
+		return nil, err
+	}
+	inventory.DAEs = daeResp.Enclosures
+
+	diskResp := &types.ListDisks{}
+	if err := c.listHardware(ctx, api.DiskAction, DiskDisplayFields, diskResp); err != nil {
+		return nil, err
+	}
+	inventory.Disks = diskResp.Disks
+
+	powerSupplyResp := &types.ListHardwareComponents{}
+	if err := c.listHardware(ctx, api.PowerSupplyAction, HardwareComponentDisplayFields, powerSupplyResp); err != nil {
+		return nil, err
+	}
+	inventory.PowerSupplies = powerSupplyResp.HardwareComponents
+
+	batteryResp := &types.ListHardwareComponents{}
+	if err := c.listHardware(ctx, api.BatteryAction, HardwareComponentDisplayFields, batteryResp); err != nil {
+		return nil, err
+	}
+	inventory.Batteries = batteryResp.HardwareComponents
+
+	fanResp := &types.ListHardwareComponents{}
+	if err := c.listHardware(ctx, api.FanAction, HardwareComponentDisplayFields, fanResp); err != nil {
+		return nil, err
+	}
+	inventory.Fans = fanResp.HardwareComponents
+
+	log.Debugf("Hardware inventory: %d SPs, %d DPEs, %d DAEs, %d disks, %d power supplies, %d batteries, %d fans",
+		len(inventory.StorageProcessors), len(inventory.DPEs), len(inventory.DAEs), len(inventory.Disks),
+		len(inventory.PowerSupplies), len(inventory.Batteries), len(inventory.Fans))
+	return inventory, nil
+}
+
+// GetSystemHealthReport gets the system health and lists every hardware component whose health is not OK.
+func (c *UnityClientImpl) GetSystemHealthReport(ctx context.Context) (*SystemHealthReport, error) {
+	systemInfo, err := c.GetSystemInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	inventory, err := c.GetHardwareInventory(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &SystemHealthReport{
+		System: ComponentHealth{Type: api.SystemAction, ID: systemInfo.ID, Name: systemInfo.Name, Health: systemInfo.Health},
+	}
+	addIfUnhealthy := func(componentType, id, name string, health types.HealthContent) {
+		if health.Value != HealthOK {
+			report.UnhealthyComponents = append(report.UnhealthyComponents, ComponentHealth{Type: componentType, ID: id, Name: name, Health: health})
+		}
+	}
+
+	for _, sp := range inventory.StorageProcessors {
+		addIfUnhealthy(api.StorageProcessorAction, sp.StorageProcessorContent.ID, sp.StorageProcessorContent.Name, sp.StorageProcessorContent.Health)
+	}
+	for _, dpe := range inventory.DPEs {
+		addIfUnhealthy(api.DpeAction, dpe.EnclosureContent.ID, dpe.EnclosureContent.Name, dpe.EnclosureContent.Health)
+	}
+	for _, dae := range inventory.DAEs {
+		addIfUnhealthy(api.DaeAction, dae.EnclosureContent.ID, dae.EnclosureContent.Name, dae.EnclosureContent.Health)
+	}
+	for _, disk := range inventory.Disks {
+		addIfUnhealthy(api.DiskAction, disk.DiskContent.ID, disk.DiskContent.Name, disk.DiskContent.Health)
+	}
+	for _, powerSupply := range inventory.PowerSupplies {
+		addIfUnhealthy(api.PowerSupplyAction, powerSupply.HardwareComponentContent.ID, powerSupply.HardwareComponentContent.Name, powerSupply.HardwareComponentContent.Health)
+	}
+	for _, battery := range inventory.Batteries {
+		addIfUnhealthy(api.BatteryAction, battery.HardwareComponentContent.ID, battery.HardwareComponentContent.Name, battery.HardwareComponentContent.Health)
+	}
+	for _, fan := range inventory.Fans {
+		addIfUnhealthy(api.FanAction, fan.HardwareComponentContent.ID, fan.HardwareComponentContent.Name, fan.HardwareComponentContent.Health)
+	}
+	return report, nil
+}
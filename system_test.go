@@ -0,0 +1,161 @@
+/*
+ Copyright © 2025 Dell Inc. or its subsidiaries. All Rights Reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package gounity
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	types "github.com/dell/gounity/apitypes"
+	mocksapi "github.com/dell/gounity/mocks/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	okHealth       = types.HealthContent{Value: HealthOK}
+	degradedHealth = types.HealthContent{Value: HealthDegraded, Descriptions: []string{"The component is degraded."}}
+)
+
+func mockSystemInfo(apiClient *mocksapi.Client, health types.HealthContent) {
+	apiClient.On("DoWithHeaders", mock.Anything, "GET", "/api/types/system/instances?fields="+SystemDisplayFields, mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(*types.ListSystems)
+		resp.Systems = []types.System{{SystemContent: types.SystemContent{ID: "0", Name: "unity-1", Model: "Unity 480F", SerialNumber: "CKM00000000001", Health: health}}}
+	}).Once()
+	apiClient.On("DoWithHeaders", mock.Anything, "GET", "/api/types/basicSystemInfo/instances?fields="+BasicSystemInfoFields, mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(*types.BasicSystemInfo)
+		resp.Entries = []types.Entries{{Content: types.Content{ID: "0", SoftwareVersion: "5.3.0", APIVersion: "13.0", EarliestAPIVersion: "4.0"}}}
+	}).Once()
+}
+
+func mockHardwareInventory(apiClient *mocksapi.Client) {
+	apiClient.On("DoWithHeaders", mock.Anything, "GET", "/api/types/storageProcessor/instances?fields="+StorageProcessorDisplayFields, mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(*types.ListStorageProcessors)
+		resp.StorageProcessors = []types.StorageProcessor{
+			{StorageProcessorContent: types.StorageProcessorContent{ID: "spa", Name: "SP A", Health: okHealth}},
+			{StorageProcessorContent: types.StorageProcessorContent{ID: "spb", Name: "SP B", Health: okHealth}},
+		}
+	}).Once()
+	apiClient.On("DoWithHeaders", mock.Anything, "GET", "/api/types/dpe/instances?fields="+EnclosureDisplayFields, mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(*types.ListEnclosures)
+		resp.Enclosures = []types.Enclosure{{EnclosureContent: types.EnclosureContent{ID: "dpe", Name: "DPE", Health: okHealth}}}
+	}).Once()
+	apiClient.On("DoWithHeaders", mock.Anything, "GET", "/api/types/dae/instances?fields="+EnclosureDisplayFields, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+	apiClient.On("DoWithHeaders", mock.Anything, "GET", "/api/types/disk/instances?fields="+DiskDisplayFields, mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(*types.ListDisks)
+		resp.Disks = []types.Disk{
+			{DiskContent: types.DiskContent{ID: "dpe_disk_0", Name: "DPE Drive 0", Health: okHealth}},
+			{DiskContent: types.DiskContent{ID: "dpe_disk_1", Name: "DPE Drive 1", Health: degradedHealth}},
+		}
+	}).Once()
+	apiClient.On("DoWithHeaders", mock.Anything, "GET", "/api/types/powerSupply/instances?fields="+HardwareComponentDisplayFields, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+	apiClient.On("DoWithHeaders", mock.Anything, "GET", "/api/types/battery/instances?fields="+HardwareComponentDisplayFields, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+	apiClient.On("DoWithHeaders", mock.Anything, "GET", "/api/types/fan/instances?fields="+HardwareComponentDisplayFields, mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(*types.ListHardwareComponents)
+		resp.HardwareComponents = []types.HardwareComponent{{HardwareComponentContent: types.HardwareComponentContent{ID: "fan_0", Name: "Cooling Module 0", Health: types.HealthContent{Value: HealthCritical}}}}
+	}).Once()
+}
+
+func TestGetSystemInfo(t *testing.T) {
+	fmt.Println("Begin - Get System Info Test")
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).ExpectedCalls = nil
+	ctx := context.Background()
+
+	mockSystemInfo(testConf.client.(*UnityClientImpl).api.(*mocksapi.Client), okHealth)
+	systemInfo, err := testConf.client.GetSystemInfo(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, "Unity 480F", systemInfo.Model)
+	assert.Equal(t, "CKM00000000001", systemInfo.SerialNumber)
+	assert.Equal(t, "5.3.0", systemInfo.SoftwareVersion)
+	assert.Equal(t, "13.0", systemInfo.APIVersion)
+
+	// Negative cases
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", anyArgs...).Return(errors.New("get system failed")).Once()
+	_, err = testConf.client.GetSystemInfo(ctx)
+	assert.Error(t, err)
+
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", anyArgs...).Return(nil).Once()
+	_, err = testConf.client.GetSystemInfo(ctx)
+	assert.Equal(t, ErrorSystemNotFound, err)
+
+	fmt.Println("Get System Info Test - Successful")
+}
+
+func TestGetBasicSystemInfo(t *testing.T) {
+	fmt.Println("Begin - Get Basic System Info Test")
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).ExpectedCalls = nil
+	ctx := context.Background()
+
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", anyArgs...).Return(errors.New("get basic system info failed")).Once()
+	_, err := testConf.client.GetBasicSystemInfo(ctx)
+	assert.Error(t, err)
+
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", anyArgs...).Return(nil).Once()
+	_, err = testConf.client.GetBasicSystemInfo(ctx)
+	assert.Equal(t, ErrorSystemNotFound, err)
+
+	fmt.Println("Get Basic System Info Test - Successful")
+}
+
+func TestGetHardwareInventory(t *testing.T) {
+	fmt.Println("Begin - Get Hardware Inventory Test")
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).ExpectedCalls = nil
+	ctx := context.Background()
+
+	mockHardwareInventory(testConf.client.(*UnityClientImpl).api.(*mocksapi.Client))
+	inventory, err := testConf.client.GetHardwareInventory(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, inventory.StorageProcessors, 2)
+	assert.Len(t, inventory.DPEs, 1)
+	assert.Empty(t, inventory.DAEs)
+	assert.Len(t, inventory.Disks, 2)
+	assert.Len(t, inventory.Fans, 1)
+
+	// Negative case
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", anyArgs...).Return(errors.New("list failed")).Once()
+	_, err = testConf.client.GetHardwareInventory(ctx)
+	assert.Error(t, err)
+
+	fmt.Println("Get Hardware Inventory Test - Successful")
+}
+
+func TestGetSystemHealthReport(t *testing.T) {
+	fmt.Println("Begin - Get System Health Report Test")
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).ExpectedCalls = nil
+	ctx := context.Background()
+
+	mockSystemInfo(testConf.client.(*UnityClientImpl).api.(*mocksapi.Client), degradedHealth)
+	mockHardwareInventory(testConf.client.(*UnityClientImpl).api.(*mocksapi.Client))
+	report, err := testConf.client.GetSystemHealthReport(ctx)
+	assert.NoError(t, err)
+	assert.False(t, report.IsHealthy())
+	assert.Equal(t, HealthDegraded, report.System.Health.Value)
+	assert.Equal(t, []ComponentHealth{
+		{Type: "disk", ID: "dpe_disk_1", Name: "DPE Drive 1", Health: degradedHealth},
+		{Type: "fan", ID: "fan_0", Name: "Cooling Module 0", Health: types.HealthContent{Value: HealthCritical}},
+	}, report.UnhealthyComponents)
+
+	healthy := &SystemHealthReport{System: ComponentHealth{Health: okHealth}}
+	assert.True(t, healthy.IsHealthy())
+
+	// Negative case
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", anyArgs...).Return(errors.New("get system failed")).Once()
+	_, err = testConf.client.GetSystemHealthReport(ctx)
+	assert.Error(t, err)
+
+	fmt.Println("Get System Health Report Test - Successful")
+}
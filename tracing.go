@@ -0,0 +1,65 @@
+/*
+ Copyright © 2025 Dell Inc. or its subsidiaries. All Rights Reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package gounity
+
+import (
+	"context"
+
+	"github.com/dell/gounity/api"
+	types "github.com/dell/gounity/apitypes"
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Span attribute keys of high-level operations
+const (
+	attributeResourceID   = attribute.Key("unity.resource.id")
+	attributeResourceName = attribute.Key("unity.resource.name")
+)
+
+// getTracer returns the tracer of the client, or the global one for clients not built by a constructor
+func (c *UnityClientImpl) getTracer() trace.Tracer {
+	if c.tracer == nil {
+		return api.Tracer(nil)
+	}
+	return c.tracer
+}
+
+// startOperation starts the parent span of a high-level operation such as CreateLun.
+// The span must be ended with endOperation.
+func (c *UnityClientImpl) startOperation(ctx context.Context, name string, attributes ...attribute.KeyValue) (context.Context, trace.Span) {
+	return c.getTracer().Start(ctx, "gounity."+name, trace.WithAttributes(attributes...))
+}
+
+// endOperation records the error of an operation, if any, and ends its span
+func endOperation(span trace.Span, err error) {
+	if err != nil {
+		recordSpanError(span, err)
+	}
+	span.End()
+}
+
+// recordSpanError records the error and its Unity error code on the span
+func recordSpanError(span trace.Span, err error) {
+	if e, ok := err.(*types.Error); ok {
+		span.SetAttributes(api.AttributeUnityErrorCode.Int(e.ErrorContent.ErrorCode))
+		if e.ErrorContent.HTTPStatusCode != 0 {
+			span.SetAttributes(api.AttributeHTTPStatusCode.Int(e.ErrorContent.HTTPStatusCode))
+		}
+	}
+	span.RecordError(err)
+	span.SetStatus(otelcodes.Error, err.Error())
+}
@@ -0,0 +1,125 @@
+/*
+ Copyright © 2025 Dell Inc. or its subsidiaries. All Rights Reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package gounity
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/dell/gounity/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+// spanAttribute returns the value of the attribute of the span
+func spanAttribute(span tracetest.SpanStub, key attribute.Key) attribute.Value {
+	for _, kv := range span.Attributes {
+		if kv.Key == key {
+			return kv.Value
+		}
+	}
+	return attribute.Value{}
+}
+
+// findSpans returns the spans with the given name
+func findSpans(spans tracetest.SpanStubs, name string) []tracetest.SpanStub {
+	var found []tracetest.SpanStub
+	for _, span := range spans {
+		if span.Name == name {
+			found = append(found, span)
+		}
+	}
+	return found
+}
+
+func TestTracing(t *testing.T) {
+	ctx := context.Background()
+	lunRequests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasPrefix(r.URL.Path, "/api/instances/lun/"):
+			lunRequests++
+			// The session expired before the first request
+			if lunRequests == 1 {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			_, _ = w.Write([]byte(`{"content": {"id": "sv_1", "name": "lun"}}`))
+		case r.URL.Path == "/api/types/loginSessionInfo":
+			w.Header().Set(api.HeaderEMCCSRFToken, "token")
+			w.WriteHeader(http.StatusOK)
+		case r.Method == http.MethodDelete:
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			_, _ = w.Write([]byte(`{"error": {"errorCode": 131149829, "httpStatusCode": 422, "messages": [{"en-US": "The storage resource is in use"}]}}`))
+		}
+	}))
+	defer server.Close()
+
+	exporter := tracetest.NewInMemoryExporter()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	client, err := NewClientWithOptions(ctx, server.URL, WithTracerProvider(tp))
+	require.NoError(t, err)
+
+	err = client.DeleteVolume(ctx, "sv_1")
+	assert.Error(t, err)
+	spans := exporter.GetSpans()
+
+	operations := findSpans(spans, "gounity.DeleteVolume")
+	require.Len(t, operations, 1)
+	operation := operations[0]
+	assert.Equal(t, otelcodes.Error, operation.Status.Code)
+	assert.Equal(t, "sv_1", spanAttribute(operation, attributeResourceID).AsString())
+
+	// The lookup was retried after re-authentication
+	lookups := findSpans(spans, "unity GET /api/instances/lun/{id}")
+	require.Len(t, lookups, 1)
+	lookup := lookups[0]
+	assert.Equal(t, operation.SpanContext.SpanID(), lookup.Parent.SpanID())
+	assert.Equal(t, int64(1), spanAttribute(lookup, api.AttributeUnityRetryCount).AsInt64())
+	assert.Equal(t, "/api/instances/lun/{id}", spanAttribute(lookup, api.AttributeURLTemplate).AsString())
+	require.Len(t, lookup.Events, 1)
+	assert.Equal(t, "reauthenticate", lookup.Events[0].Name)
+	assert.Len(t, findSpans(spans, "GET /api/instances/lun/{id}"), 2)
+	assert.Len(t, findSpans(spans, "GET /api/types/loginSessionInfo"), 1)
+
+	// The delete failed with a Unity error code
+	deletes := findSpans(spans, "unity DELETE /api/instances/storageResource/{id}")
+	require.Len(t, deletes, 1)
+	assert.Equal(t, otelcodes.Error, deletes[0].Status.Code)
+	assert.Equal(t, int64(131149829), spanAttribute(deletes[0], api.AttributeUnityErrorCode).AsInt64())
+	requests := findSpans(spans, "DELETE /api/instances/storageResource/{id}")
+	require.Len(t, requests, 1)
+	assert.Equal(t, deletes[0].SpanContext.SpanID(), requests[0].Parent.SpanID())
+	assert.Equal(t, int64(http.StatusUnprocessableEntity), spanAttribute(requests[0], api.AttributeHTTPStatusCode).AsInt64())
+
+	// Pool changes have an operation span too
+	exporter.Reset()
+	assert.Error(t, client.DeleteStoragePool(ctx, "pool_1"))
+	spans = exporter.GetSpans()
+	operations = findSpans(spans, "gounity.DeleteStoragePool")
+	require.Len(t, operations, 1)
+	assert.Equal(t, otelcodes.Error, operations[0].Status.Code)
+	assert.Equal(t, "pool_1", spanAttribute(operations[0], attributeResourceID).AsString())
+	deletes = findSpans(spans, "unity DELETE /api/instances/pool/{id}")
+	require.Len(t, deletes, 1)
+	assert.Equal(t, operations[0].SpanContext.SpanID(), deletes[0].Parent.SpanID())
+}
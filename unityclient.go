@@ -18,17 +18,23 @@ import (
 	"bytes"
 	"context"
 	"encoding/base64"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"os"
 	"strconv"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	util "github.com/dell/gounity/gounityutil"
 
 	"github.com/dell/gounity/api"
 	types "github.com/dell/gounity/apitypes"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -38,9 +44,6 @@ const (
 )
 
 var (
-	accHeader string
-	conHeader string
-
 	debug, _    = strconv.ParseBool(os.Getenv("GOUNITY_DEBUG"))
 	showHTTP, _ = strconv.ParseBool(os.Getenv("GOUNITY_SHOWHTTP"))
 	errNoLink   = errors.New("error: problem finding link")
@@ -65,6 +68,8 @@ type UnityClient interface {
 	FindNFSShareByID(ctx context.Context, nfsShareID string) (*types.NFSShare, error)
 	FindNFSShareByName(ctx context.Context, nfsSharename string) (*types.NFSShare, error)
 	GetFilesystemIDFromResID(ctx context.Context, filesystemResID string) (string, error)
+	FindStorageResourceByID(ctx context.Context, resourceID string) (*types.StorageResourceParameters, error)
+	ModifyFilesystem(ctx context.Context, filesystemID string, params types.FsModifyParameters) error
 	ModifyNFSShareCreatedFromSnapshotHostAccess(ctx context.Context, nfsShareID string, hostIDs []string, accessType AccessType) error
 	ModifyNFSShareHostAccess(ctx context.Context, filesystemID string, nfsShareID string, hostIDs []string, accessType AccessType) error
 	FindHostByName(ctx context.Context, hostName string) (*types.Host, error)
@@ -78,6 +83,7 @@ type UnityClient interface {
 	CreateHostInitiator(ctx context.Context, hostID, wwnOrIqn string, initiatorType types.InitiatorType) (*types.HostInitiator, error)
 	ModifyHostInitiator(ctx context.Context, hostID string, initiator *types.HostInitiator) (*types.HostInitiator, error)
 	ModifyHostInitiatorByID(ctx context.Context, hostID, initiatorID string) (*types.HostInitiator, error)
+	DeleteHostInitiator(ctx context.Context, initiatorID string) error
 	FindHostInitiatorPathByID(ctx context.Context, initiatorPathID string) (*types.HostInitiatorPath, error)
 	FindFcPortByID(ctx context.Context, fcPortID string) (*types.FcPort, error)
 	FindTenants(ctx context.Context) (*types.TenantInfo, error)
@@ -85,6 +91,9 @@ type UnityClient interface {
 	CreateRealTimeMetricsQuery(ctx context.Context, metricPaths []string, interval int) (*types.MetricQueryCreateResponse, error)
 	DeleteRealTimeMetricsQuery(ctx context.Context, queryID int) error
 	GetAllRealTimeMetricPaths(ctx context.Context) error
+	ListMetrics(ctx context.Context, filter string) ([]types.MetricInstance, error)
+	SetMetricsCacheTTL(ttl time.Duration)
+	ListMetricValues(ctx context.Context, path string) ([]types.MetricValue, error)
 	GetCapacity(ctx context.Context) (*types.SystemCapacityMetricsQueryResult, error)
 	GetMetricsCollection(ctx context.Context, queryID int) (*types.MetricQueryResult, error)
 	CopySnapshot(ctx context.Context, sourceSnapshotID string, name string) (*types.Snapshot, error)
@@ -111,9 +120,51 @@ type UnityClient interface {
 	GetMaxVolumeSize(ctx context.Context, systemLimitID string) (*types.MaxVolumSizeInfo, error)
 	ListVolumes(ctx context.Context, startToken int, maxEntries int) ([]types.Volume, int, error)
 	ModifyVolumeExport(ctx context.Context, volID string, hostIDList []string) error
+	ModifyVolume(ctx context.Context, volID string, params types.VolumeModifyParam) error
 	RenameVolume(ctx context.Context, newName string, volID string) error
 	UnexportVolume(ctx context.Context, volID string) error
 	GetAllNFSServers(ctx context.Context) (*types.NFSServersResponse, error)
+	ListAlerts(ctx context.Context, minSeverity AlertSeverity, since time.Time) ([]types.Alert, error)
+	AcknowledgeAlert(ctx context.Context, alertID string) error
+	DeleteAlert(ctx context.Context, alertID string) error
+	ListEvents(ctx context.Context, minSeverity AlertSeverity, since time.Time) ([]types.Event, error)
+	WatchAlerts(ctx context.Context, since time.Time, interval time.Duration) <-chan types.Alert
+	GetBasicSystemInfo(ctx context.Context) (*types.Content, error)
+	GetSystemInfo(ctx context.Context) (*SystemInfo, error)
+	GetHardwareInventory(ctx context.Context) (*HardwareInventory, error)
+	GetSystemHealthReport(ctx context.Context) (*SystemHealthReport, error)
+	GetCapabilities() *Capabilities
+	Supports(feature Feature) bool
+	ListLicenses(ctx context.Context) ([]types.License, error)
+	HasFeature(ctx context.Context, feature LicenseType) (bool, error)
+	SetLicenseCacheTTL(ttl time.Duration)
+	SetResourceCacheTTL(ttl time.Duration)
+	ListStoragePools(ctx context.Context) ([]types.StoragePool, error)
+	CreateStoragePool(ctx context.Context, name, description string, raidGroups []types.RaidGroupParameters) (*types.StoragePool, error)
+	ExpandStoragePool(ctx context.Context, poolID string, raidGroups []types.RaidGroupParameters) error
+	ModifyStoragePool(ctx context.Context, poolID string, params types.PoolModifyParam) error
+	DeleteStoragePool(ctx context.Context, poolID string) error
+	ListPoolUnits(ctx context.Context) ([]types.PoolUnit, error)
+	ListDiskGroups(ctx context.Context) ([]types.DiskGroup, error)
+	GetSessionInfo(ctx context.Context) (*SessionInfo, error)
+	StartSessionKeepAlive(ctx context.Context)
+	Logout(ctx context.Context) error
+	Close() error
+	EnsureVolume(ctx context.Context, name, poolID, description string, size uint64, fastVPTieringPolicy int, hostIOLimitID string, isThinEnabled, isDataReductionEnabled bool) (*types.Volume, error)
+	EnsureFilesystem(ctx context.Context, name, storagepool, description, nasServer string, size uint64, tieringPolicy, hostIOSize, supportedProtocol int, isThinEnabled, isDataReductionEnabled bool) (*types.Filesystem, error)
+	EnsureSnapshot(ctx context.Context, storageResourceID, snapshotName, description, retentionDuration string) (*types.Snapshot, error)
+	EnsureHost(ctx context.Context, hostName string, tenantID string) (*types.Host, error)
+	EnsureNFSShare(ctx context.Context, name, path, filesystemID string, nfsShareDefaultAccess NFSShareDefaultAccess) (*types.NFSShare, error)
+	ListSnapshotSchedules(ctx context.Context) ([]types.SnapSchedule, error)
+	CreateSnapshotSchedule(ctx context.Context, name string, rules []types.SnapScheduleRule) (*types.SnapSchedule, error)
+	DeleteSnapshotSchedule(ctx context.Context, scheduleID string) error
+	ListFilesystems(ctx context.Context) ([]types.Filesystem, error)
+	ListReplicationSessions(ctx context.Context) ([]types.ReplicationSession, error)
+	BuildDependencyGraph(ctx context.Context) (*DependencyGraph, error)
+	PlanDelete(ctx context.Context, resourceID string) (*DeletePlan, error)
+	CollectMarkedResources(ctx context.Context, opts CollectOptions) (*CollectReport, error)
+	ListHosts(ctx context.Context) ([]types.Host, error)
+	AuditOrphans(ctx context.Context, opts AuditOptions) (*AuditReport, error)
 }
 
 // UnityClientImpl Struct holds the configuration & REST Client.
@@ -121,14 +172,35 @@ type UnityClientImpl struct {
 	configConnect *ConfigConnect
 	api           api.Client
 	loginMutex    sync.Mutex
+	metricsCache  metricsCache
+	licenseCache  licenseCache
+	resourceCache resourceCache
+	capabilities  atomic.Pointer[Capabilities]
+	session       sessionState
+	tracer        trace.Tracer
+	metrics       *api.ClientMetrics
 }
 
 // ConfigConnect Struct holds the endpoint & credential info.
+// If CredentialProvider is set, it is asked for the credentials at each login instead of using Username and Password.
 type ConfigConnect struct {
-	Endpoint string
-	Username string
-	Password string
-	Insecure bool
+	Endpoint           string
+	Username           string
+	Password           string
+	Insecure           bool
+	CredentialProvider CredentialProvider
+}
+
+// credentials returns the credentials to login with
+func (c *ConfigConnect) credentials(ctx context.Context) (*Credentials, error) {
+	if c.CredentialProvider == nil {
+		return &Credentials{Username: c.Username, Password: c.Password}, nil
+	}
+	credentials, err := c.CredentialProvider.Credentials(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get credentials: %v", err)
+	}
+	return credentials, nil
 }
 
 // BasicSystemInfo make a REST API call [/basicSystemInfo/instances] to Unity to check if array is responding.
@@ -139,7 +211,7 @@ func (c *UnityClientImpl) BasicSystemInfo(ctx context.Context, configConnect *Co
 	headers := make(map[string]string, 3)
 	headers[api.XEmcRestClient] = "true"
 	headers[api.HeaderKeyContentType] = api.HeaderValContentTypeJSON
-	resp, err := c.api.DoAndGetResponseBody(ctx, http.MethodGet, api.UnityAPIBasicSysInfoURI, headers, nil)
+	resp, err := c.api.DoAndGetResponseBody(ctx, http.MethodGet, fmt.Sprintf(api.UnityAPIInstanceTypeResourcesWithFields, api.BasicSystemInfoAction, BasicSystemInfoFields), headers, nil)
 	if err != nil {
 		return fmt.Errorf("Error getting BasicSystemInfo: %v", err)
 	}
@@ -156,6 +228,7 @@ func (c *UnityClientImpl) BasicSystemInfo(ctx context.Context, configConnect *Co
 		case resp.StatusCode >= 200 && resp.StatusCode <= 299:
 			{
 				log.Debug("Getting BasicSystemInfo details successful")
+				c.detectCapabilities(ctx, resp.Body)
 			}
 		default:
 			return fmt.Errorf("Get BaicSystemInfo error. Response: %v", c.api.ParseJSONError(ctx, resp))
@@ -175,9 +248,13 @@ func (c *UnityClientImpl) Authenticate(ctx context.Context, configConnect *Confi
 	log := util.GetRunIDLogger(ctx)
 	log.Debug("Executing Authenticate REST client")
 	c.configConnect = configConnect
+	credentials, err := configConnect.credentials(ctx)
+	if err != nil {
+		return fmt.Errorf("authentication error: %v", err)
+	}
 	c.api.SetToken("")
 	headers := make(map[string]string, 3)
-	headers[api.AuthorizationHeader] = "Basic " + basicAuth(configConnect.Username, configConnect.Password)
+	headers[api.AuthorizationHeader] = "Basic " + basicAuth(credentials.Username, credentials.Password)
 	headers[api.XEmcRestClient] = "true"
 	headers[api.HeaderKeyContentType] = api.HeaderValContentTypeJSON
 	resp, err := c.api.DoAndGetResponseBody(ctx, http.MethodGet, api.UnityAPILoginSessionInfoURI, headers, nil)
@@ -207,12 +284,45 @@ func (c *UnityClientImpl) Authenticate(ctx context.Context, configConnect *Confi
 		}
 
 		c.api.SetToken(resp.Header.Get(emcCsrfToken))
+		c.session.touch()
 	} else {
 		log.Errorf("Authenticate error: Nil response received")
 	}
 	return nil
 }
 
+// detectCapabilities builds the capability set of the array from the basicSystemInfo response body.
+// If the body cannot be decoded the capabilities stay unknown and every feature is assumed to be supported.
+func (c *UnityClientImpl) detectCapabilities(ctx context.Context, body io.Reader) {
+	log := util.GetRunIDLogger(ctx)
+	basicSystemInfo := &types.BasicSystemInfo{}
+	if err := json.NewDecoder(body).Decode(basicSystemInfo); err != nil || len(basicSystemInfo.Entries) == 0 {
+		log.Warnf("Unable to detect array capabilities from BasicSystemInfo, error: %v", err)
+		return
+	}
+	c.setCapabilities(ctx, &basicSystemInfo.Entries[0].Content)
+}
+
+// setCapabilities replaces the capability set of the array
+func (c *UnityClientImpl) setCapabilities(ctx context.Context, info *types.Content) {
+	log := util.GetRunIDLogger(ctx)
+	capabilities := NewCapabilities(info)
+	log.Debugf("Array software version: %s, API version: %s, earliest API version: %s", capabilities.SoftwareVersion, capabilities.APIVersion, capabilities.EarliestAPIVersion)
+	c.capabilities.Store(capabilities)
+}
+
+// GetCapabilities returns the capability set detected by BasicSystemInfo or GetBasicSystemInfo.
+// It returns nil if the capabilities were not detected yet.
+func (c *UnityClientImpl) GetCapabilities() *Capabilities {
+	return c.capabilities.Load()
+}
+
+// Supports returns true if the array supports the given feature.
+// Every feature is assumed to be supported until the capabilities are detected.
+func (c *UnityClientImpl) Supports(feature Feature) bool {
+	return c.GetCapabilities().Supports(feature)
+}
+
 // basicAuth converts the given username & password to Base64 encoded string.
 func basicAuth(username, password string) string {
 	auth := username + ":" + password
@@ -223,14 +333,18 @@ func basicAuth(username, password string) string {
 // In case if the given EMC-CSRF-TOKEN becomes invalid, retries the same operation after performing authentication.
 func (c *UnityClientImpl) executeWithRetryAuthenticate(ctx context.Context, method, uri string, body, resp interface{}) error {
 	log := util.GetRunIDLogger(ctx)
-	headers := make(map[string]string, 2)
-	headers[api.HeaderKeyAccept] = accHeader
-	headers[api.HeaderKeyContentType] = conHeader
+	headers := make(map[string]string, 1)
 	headers[api.XEmcRestClient] = "true"
+	uri = c.GetCapabilities().filterURIFields(uri)
+	route := api.RouteTemplate(uri)
+	ctx, span := c.getTracer().Start(ctx, "unity "+method+" "+route,
+		trace.WithAttributes(api.AttributeHTTPMethod.String(method), api.AttributeURLTemplate.String(route), api.AttributeUnityRetryCount.Int(0)))
+	defer span.End()
 	log.Debug("Invoking REST API server info Method: ", method, ", URI: ", uri)
 	err := c.api.DoWithHeaders(ctx, method, uri, headers, body, resp)
 	if err == nil {
 		log.Debug("Execution successful on Method: ", method, ", URI: ", uri)
+		c.session.touch()
 		return nil
 	}
 	// check if we need to authenticate
@@ -240,15 +354,29 @@ func (c *UnityClientImpl) executeWithRetryAuthenticate(ctx context.Context, meth
 			log.Debug("need to re-authenticate")
 			// Authenticate then try again
 			if err := c.Authenticate(ctx, c.configConnect); err != nil {
+				c.metrics.ObserveReauthentication(false)
+				span.AddEvent("reauthenticate", trace.WithAttributes(attribute.Bool("success", false)))
+				recordSpanError(span, err)
 				return fmt.Errorf("authentication failure due to: %v", err)
 			}
+			c.metrics.ObserveReauthentication(true)
+			c.metrics.ObserveRetry(method, uri)
+			span.AddEvent("reauthenticate", trace.WithAttributes(attribute.Bool("success", true)))
+			span.SetAttributes(api.AttributeUnityRetryCount.Int(1))
 			log.Debug("Authentication success")
-			return c.api.DoWithHeaders(ctx, method, uri, headers, body, resp)
+			err = c.api.DoWithHeaders(ctx, method, uri, headers, body, resp)
+			if err == nil {
+				c.session.touch()
+				return nil
+			}
+			recordSpanError(span, err)
+			return err
 		}
 	} else {
 		log.Debugf("Error is not a type of \"*apitypes.Error\". Error: %v", err)
 	}
 	log.WithError(err).Debug("failed to invoke Unity REST API server")
+	recordSpanError(span, err)
 
 	return err
 }
@@ -271,16 +399,25 @@ func NewClient(ctx context.Context) (UnityClient, error) {
 
 // NewClientWithArgs initialize the new REST Client with the given arguments.
 func NewClientWithArgs(ctx context.Context, endpoint string, insecure bool) (UnityClient, error) {
+	return NewClientWithClientOptions(ctx, endpoint, api.ClientOptions{Insecure: insecure})
+}
+
+// NewClientWithClientOptions initialize the new REST Client with the given HTTP client options,
+// such as a CA bundle, pinned certificate fingerprints or a client certificate.
+func NewClientWithClientOptions(ctx context.Context, endpoint string, opts api.ClientOptions) (UnityClient, error) {
 	log := util.GetRunIDLogger(ctx)
 	if util.ShowHTTP {
 		util.Debug = true
 	}
 
 	fields := map[string]interface{}{
-		"endpoint": endpoint,
-		"insecure": insecure,
-		"debug":    util.Debug,
-		"showHTTP": util.ShowHTTP,
+		"endpoint":   endpoint,
+		"insecure":   opts.Insecure,
+		"pinned":     len(opts.PinnedSPKIFingerprints) > 0,
+		"serverName": opts.ServerName,
+		"timeout":    opts.Timeout,
+		"debug":      util.Debug,
+		"showHTTP":   util.ShowHTTP,
 	}
 
 	log.WithFields(fields).Debug("unity client init")
@@ -290,9 +427,13 @@ func NewClientWithArgs(ctx context.Context, endpoint string, insecure bool) (Uni
 		return nil, withFields(fields, "endpoint is required")
 	}
 
-	opts := api.ClientOptions{
-		Insecure: insecure,
-		ShowHTTP: util.ShowHTTP,
+	opts.ShowHTTP = opts.ShowHTTP || util.ShowHTTP
+	if opts.Metrics == nil && opts.MetricsRegisterer != nil {
+		metrics, err := api.NewClientMetrics(opts.MetricsRegisterer)
+		if err != nil {
+			return nil, fmt.Errorf("unable to register client metrics: %v", err)
+		}
+		opts.Metrics = metrics
 	}
 
 	ac, err := api.New(ctx, endpoint, opts, util.Debug)
@@ -304,7 +445,10 @@ func NewClientWithArgs(ctx context.Context, endpoint string, insecure bool) (Uni
 		api:           ac,
 		configConnect: &ConfigConnect{},
 	}
-	conHeader = api.HeaderValContentTypeJSON
+	client.tracer = api.Tracer(opts.TracerProvider)
+	client.metrics = opts.Metrics
+	client.SetMetricsCacheTTL(DefaultMetricsCacheTTL)
+	client.SetLicenseCacheTTL(DefaultLicenseCacheTTL)
 	return client, nil
 }
 
@@ -345,6 +345,9 @@ func (m *mocksapiClient) GetToken() string {
 	return m.Token
 }
 
+// ClearCookies does nothing in the mock API client.
+func (m *mocksapiClient) ClearCookies() {}
+
 func TestSetToken(t *testing.T) {
 	mocksapiClient := &mocksapiClient{}
 	client := &UnityClientImpl{api: mocksapiClient}
@@ -81,7 +81,10 @@ var MarkVolumeForDeletion = "csi-marked-vol-for-deletion"
 //  2. Size of Lun should be in bytes.
 func (c *UnityClientImpl) CreateLun(ctx context.Context, name, poolID, description string, size uint64, fastVPTieringPolicy int,
 	hostIOLimitID string, isThinEnabled, isDataReductionEnabled bool,
-) (*types.Volume, error) {
+) (_ *types.Volume, err error) {
+	ctx, span := c.startOperation(ctx, "CreateLun", attributeResourceName.String(name))
+	defer func() { endOperation(span, err) }()
+	defer c.resourceCache.invalidate(cachePools)
 	log := util.GetRunIDLogger(ctx)
 
 	if name == "" {
@@ -92,6 +95,18 @@ func (c *UnityClientImpl) CreateLun(ctx context.Context, name, poolID, descripti
 		return nil, fmt.Errorf("lun name %s should not exceed 63 characters", name)
 	}
 
+	if isDataReductionEnabled {
+		if err := c.GetCapabilities().Require(FeatureDataReduction); err != nil {
+			return nil, err
+		}
+	}
+
+	if hostIOLimitID != "" {
+		if err := c.GetCapabilities().Require(FeatureIOLimitPolicy); err != nil {
+			return nil, err
+		}
+	}
+
 	pool, err := c.FindStoragePoolByID(ctx, poolID)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get PoolID (%s) Error:%v", poolID, err)
@@ -167,7 +182,7 @@ func (c *UnityClientImpl) CreateLun(ctx context.Context, name, poolID, descripti
 	return volumeResp, nil
 }
 
-// FindVolumeByName - Find the volume by it's name. If the volume is not found, an error will be returned.
+// FindVolumeByName - Find the volume by it's name. If the volume is not found, ErrorVolumeNotFound will be returned.
 func (c *UnityClientImpl) FindVolumeByName(ctx context.Context, volName string) (*types.Volume, error) {
 	if len(volName) == 0 {
 		return nil, fmt.Errorf("lun Name shouldn't be empty")
@@ -175,6 +190,9 @@ func (c *UnityClientImpl) FindVolumeByName(ctx context.Context, volName string)
 	volumeResp := &types.Volume{}
 	err := c.executeWithRetryAuthenticate(ctx, http.MethodGet, fmt.Sprintf(api.UnityAPIGetResourceByNameWithFieldsURI, api.LunAction, volName, LunDisplayFields), nil, volumeResp)
 	if err != nil {
+		if strings.Contains(err.Error(), VolumeNotFoundErrorCode) {
+			return nil, ErrorVolumeNotFound
+		}
 		return nil, fmt.Errorf("unable to find volume by name %s", volName)
 	}
 
@@ -223,7 +241,10 @@ func (c *UnityClientImpl) ListVolumes(ctx context.Context, startToken int, maxEn
 }
 
 // DeleteVolume - Delete Volume by its ID. If the Volume is not present on the array, an error will be returned.
-func (c *UnityClientImpl) DeleteVolume(ctx context.Context, volumeID string) error {
+func (c *UnityClientImpl) DeleteVolume(ctx context.Context, volumeID string) (err error) {
+	ctx, span := c.startOperation(ctx, "DeleteVolume", attributeResourceID.String(volumeID))
+	defer func() { endOperation(span, err) }()
+	defer c.resourceCache.invalidate(cachePools)
 	log := util.GetRunIDLogger(ctx)
 	if len(volumeID) == 0 {
 		return errors.New("Volume Id cannot be empty")
@@ -278,7 +299,9 @@ func (c *UnityClientImpl) DeleteVolume(ctx context.Context, volumeID string) err
 }
 
 // ExportVolume - Export volume to a host
-func (c *UnityClientImpl) ExportVolume(ctx context.Context, volID, hostID string) error {
+func (c *UnityClientImpl) ExportVolume(ctx context.Context, volID, hostID string) (err error) {
+	ctx, span := c.startOperation(ctx, "ExportVolume", attributeResourceID.String(volID))
+	defer func() { endOperation(span, err) }()
 	hostIDContent := types.HostIDContent{
 		ID: hostID,
 	}
@@ -298,7 +321,9 @@ func (c *UnityClientImpl) ExportVolume(ctx context.Context, volID, hostID string
 }
 
 // ModifyVolumeExport - Export volume to multiple hosts / Modify the host access list on a given Volume
-func (c *UnityClientImpl) ModifyVolumeExport(ctx context.Context, volID string, hostIDList []string) error {
+func (c *UnityClientImpl) ModifyVolumeExport(ctx context.Context, volID string, hostIDList []string) (err error) {
+	ctx, span := c.startOperation(ctx, "ModifyVolumeExport", attributeResourceID.String(volID))
+	defer func() { endOperation(span, err) }()
 	hostAccessArray := []types.HostAccess{}
 	for _, hostID := range hostIDList {
 		hostIDContent := types.HostIDContent{
@@ -322,7 +347,9 @@ func (c *UnityClientImpl) ModifyVolumeExport(ctx context.Context, volID string,
 }
 
 // UnexportVolume - Unexport volume
-func (c *UnityClientImpl) UnexportVolume(ctx context.Context, volID string) error {
+func (c *UnityClientImpl) UnexportVolume(ctx context.Context, volID string) (err error) {
+	ctx, span := c.startOperation(ctx, "UnexportVolume", attributeResourceID.String(volID))
+	defer func() { endOperation(span, err) }()
 	hostAccessArray := []types.HostAccess{}
 	lunParams := types.LunHostAccessParameters{
 		HostAccess: &hostAccessArray,
@@ -334,7 +361,10 @@ func (c *UnityClientImpl) UnexportVolume(ctx context.Context, volID string) erro
 }
 
 // ExpandVolume - Expand volume to provided capacity
-func (c *UnityClientImpl) ExpandVolume(ctx context.Context, volumeID string, newSize uint64) error {
+func (c *UnityClientImpl) ExpandVolume(ctx context.Context, volumeID string, newSize uint64) (err error) {
+	ctx, span := c.startOperation(ctx, "ExpandVolume", attributeResourceID.String(volumeID))
+	defer func() { endOperation(span, err) }()
+	defer c.resourceCache.invalidate(cachePools)
 	log := util.GetRunIDLogger(ctx)
 	vol, err := c.FindVolumeByID(ctx, volumeID)
 	if err != nil {
@@ -361,7 +391,7 @@ func (c *UnityClientImpl) FindHostIOLimitByName(ctx context.Context, hostIoPolic
 		return nil, errors.New("policy Name shouldn't be empty")
 	}
 	ioLimitPolicyResp := &types.IoLimitPolicy{}
-	err := c.executeWithRetryAuthenticate(ctx, http.MethodGet, fmt.Sprintf(api.UnityAPIGetResourceByNameWithFieldsURI, api.IOLimitPolicy, hostIoPolicyName, HostIOLimitFields), nil, ioLimitPolicyResp)
+	err := c.getCached(ctx, cacheIOLimitPolicies, fmt.Sprintf(api.UnityAPIGetResourceByNameWithFieldsURI, api.IOLimitPolicy, hostIoPolicyName, HostIOLimitFields), ioLimitPolicyResp)
 	if err != nil {
 		return nil, fmt.Errorf("unable to find IO Limit Policy:%s Error: %v", hostIoPolicyName, err)
 	}
@@ -369,7 +399,13 @@ func (c *UnityClientImpl) FindHostIOLimitByName(ctx context.Context, hostIoPolic
 }
 
 // CreteLunThinClone - Create a lun thin clone
-func (c *UnityClientImpl) CreteLunThinClone(ctx context.Context, name, snapID, volID string) (*types.Volume, error) {
+func (c *UnityClientImpl) CreteLunThinClone(ctx context.Context, name, snapID, volID string) (_ *types.Volume, err error) {
+	ctx, span := c.startOperation(ctx, "CreteLunThinClone", attributeResourceName.String(name))
+	defer func() { endOperation(span, err) }()
+	defer c.resourceCache.invalidate(cachePools)
+	if err := c.GetCapabilities().Require(FeatureThinClone); err != nil {
+		return nil, err
+	}
 	snapIDContent := types.SnapshotIDContent{
 		ID: snapID,
 	}
@@ -378,23 +414,19 @@ func (c *UnityClientImpl) CreteLunThinClone(ctx context.Context, name, snapID, v
 		Name:          name,
 	}
 	volumeResp := &types.Volume{}
-	err := c.executeWithRetryAuthenticate(ctx, http.MethodPost, fmt.Sprintf(api.UnityAPICreateLunThinCloneURI, volID), createLunThinCloneParam, volumeResp)
+	err = c.executeWithRetryAuthenticate(ctx, http.MethodPost, fmt.Sprintf(api.UnityAPICreateLunThinCloneURI, volID), createLunThinCloneParam, volumeResp)
 	return volumeResp, err
 }
 
-// isFeatureLicensed - Get License information
-func (c *UnityClientImpl) isFeatureLicensed(ctx context.Context, featureName LicenseType) (*types.LicenseInfo, error) {
-	licenseInfoResp := &types.LicenseInfo{}
-	err := c.executeWithRetryAuthenticate(ctx, http.MethodGet, fmt.Sprintf(api.UnityAPIGetResourceByNameWithFieldsURI, api.LicenseAction, featureName, LicenseInfoDisplayFields), nil, licenseInfoResp)
-	if err != nil {
-		return nil, fmt.Errorf("unable to get license info for feature: %s", featureName)
-	}
-	return licenseInfoResp, nil
-}
-
 // CreateCloneFromVolume - Volume cloning
-func (c *UnityClientImpl) CreateCloneFromVolume(ctx context.Context, name, volID string) (*types.Volume, error) {
+func (c *UnityClientImpl) CreateCloneFromVolume(ctx context.Context, name, volID string) (_ *types.Volume, err error) {
+	ctx, span := c.startOperation(ctx, "CreateCloneFromVolume", attributeResourceName.String(name))
+	defer func() { endOperation(span, err) }()
+	defer c.resourceCache.invalidate(cachePools)
 	log := util.GetRunIDLogger(ctx)
+	if err := c.GetCapabilities().Require(FeatureThinClone); err != nil {
+		return nil, err
+	}
 	// Create snapshot for cloning
 	snapName := SnapForClone + strconv.FormatInt(time.Now().Unix(), 10)
 	snapResp, err := c.CreateSnapshot(ctx, volID, snapName, "", "")
@@ -420,13 +452,30 @@ func (c *UnityClientImpl) CreateCloneFromVolume(ctx context.Context, name, volID
 }
 
 // RenameVolume - Rename Volume
-func (c *UnityClientImpl) RenameVolume(ctx context.Context, newName, volID string) error {
+func (c *UnityClientImpl) RenameVolume(ctx context.Context, newName, volID string) (err error) {
+	ctx, span := c.startOperation(ctx, "RenameVolume", attributeResourceID.String(volID))
+	defer func() { endOperation(span, err) }()
 	lunParams := types.LunParameters{
 		Name: newName,
 	}
 	return c.executeWithRetryAuthenticate(ctx, http.MethodPost, fmt.Sprintf(api.UnityModifyLunURI, volID), lunParams, nil)
 }
 
+// ModifyVolume - Modify the description, FAST VP tiering policy, host I/O limit policy and snapshot schedule of the
+// volume. Fields left empty in the params are not modified.
+func (c *UnityClientImpl) ModifyVolume(ctx context.Context, volID string, params types.VolumeModifyParam) (err error) {
+	ctx, span := c.startOperation(ctx, "ModifyVolume", attributeResourceID.String(volID))
+	defer func() { endOperation(span, err) }()
+	if volID == "" {
+		return errors.New("lun ID shouldn't be empty")
+	}
+	err = c.executeWithRetryAuthenticate(ctx, http.MethodPost, fmt.Sprintf(api.UnityModifyLunURI, volID), params, nil)
+	if err != nil {
+		return fmt.Errorf("modify volume %s failed. Error: %v", volID, err)
+	}
+	return nil
+}
+
 // GetMaxVolumeSize - Returns the max size of a volume supported by the array
 func (c *UnityClientImpl) GetMaxVolumeSize(ctx context.Context, systemLimitID string) (*types.MaxVolumSizeInfo, error) {
 	volumeResp := &types.MaxVolumSizeInfo{}
@@ -75,11 +75,14 @@ func TestCreateLun(t *testing.T) {
 
 	// Mock FindStoragePoolByID to return nil
 	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", anyArgs...).Return(nil).Once()
-	// Mock isFeatureLicensed to return expected response
-	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.AnythingOfType("*apitypes.LicenseInfo")).Return(nil).
+	// Mock ListLicenses to return expected response
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.AnythingOfType("*apitypes.ListLicenses")).Return(nil).
 		Run(func(args mock.Arguments) {
-			resp := args.Get(5).(*types.LicenseInfo)
-			*resp = types.LicenseInfo{LicenseInfoContent: types.LicenseInfoContent{IsInstalled: true, IsValid: true}}
+			resp := args.Get(5).(*types.ListLicenses)
+			resp.Licenses = []types.License{
+				{LicenseContent: types.LicenseContent{ID: string(ThinProvisioning), IsInstalled: true, IsValid: true}},
+				{LicenseContent: types.LicenseContent{ID: string(DataReduction), IsInstalled: true, IsValid: true}},
+			}
 		}).Twice()
 	// Mock create request to return nil
 	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", anyArgs...).Return(nil).Once()
@@ -112,11 +115,14 @@ func TestCreateLun(t *testing.T) {
 
 	// Mock FindStoragePoolByID to return no error
 	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", anyArgs...).Return(nil).Once()
-	// Mock isFeatureLicensed to return expected response
-	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.AnythingOfType("*apitypes.LicenseInfo")).Return(nil).
+	// Mock ListLicenses to return expected response
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.AnythingOfType("*apitypes.ListLicenses")).Return(nil).
 		Run(func(args mock.Arguments) {
-			resp := args.Get(5).(*types.LicenseInfo)
-			*resp = types.LicenseInfo{LicenseInfoContent: types.LicenseInfoContent{IsInstalled: true, IsValid: true}}
+			resp := args.Get(5).(*types.ListLicenses)
+			resp.Licenses = []types.License{
+				{LicenseContent: types.LicenseContent{ID: string(ThinProvisioning), IsInstalled: true, IsValid: true}},
+				{LicenseContent: types.LicenseContent{ID: string(DataReduction), IsInstalled: true, IsValid: true}},
+			}
 		}).Twice()
 	// Mock create volume to return error
 	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", anyArgs...).Return(fmt.Errorf("volume already exists")).Once()
@@ -156,6 +162,10 @@ func TestFindVolumeByName(t *testing.T) {
 		t.Fatalf("Find volume by Name with invalid name case failed: %v", err)
 	}
 
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", anyArgs...).Return(fmt.Errorf("error code %s", VolumeNotFoundErrorCode)).Once()
+	_, err = testConf.client.FindVolumeByName(ctx, volNameTemp)
+	assert.ErrorIs(t, err, ErrorVolumeNotFound)
+
 	fmt.Println("Find Volume by Name Test - Successful")
 }
 
@@ -390,6 +400,31 @@ func TestModifyVolumeExportTest(t *testing.T) {
 	fmt.Println("Modify Volume Export Test Successful")
 }
 
+func TestModifyVolume(t *testing.T) {
+	fmt.Println("Begin - Modify Volume Test")
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).ExpectedCalls = nil
+	ctx := context.Background()
+
+	params := types.VolumeModifyParam{
+		Description:            "vol",
+		LunParameters:          &types.LunParameters{FastVPParameters: &types.FastVPParameters{TieringPolicy: 1}},
+		SnapScheduleParameters: &types.SnapScheduleParameters{SnapSchedule: &types.SnapScheduleID{ID: "snapSch_1"}},
+	}
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", mock.Anything, "POST", "/api/instances/storageResource/sv_1/action/modifyLun", mock.Anything, params, mock.Anything).Return(nil).Once()
+	err := testConf.client.ModifyVolume(ctx, "sv_1", params)
+	assert.NoError(t, err)
+
+	// Negative cases
+	err = testConf.client.ModifyVolume(ctx, "", params)
+	assert.Error(t, err)
+
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", anyArgs...).Return(errors.New("modify failed")).Once()
+	err = testConf.client.ModifyVolume(ctx, "sv_1", params)
+	assert.Error(t, err)
+
+	fmt.Println("Modify Volume Test - Successful")
+}
+
 func TestDeleteVolumeTest(t *testing.T) {
 	fmt.Println("Begin - Delete Volume Test")
 	ctx := context.Background()